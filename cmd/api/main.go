@@ -32,17 +32,45 @@ func main() {
 
 	// Initialize repository
 	restaurantRepo := repository.NewRestaurantRepository(queries)
+	txRunner := repository.NewTxRunner(pool)
 
 	// Initialize use cases
-	createRestaurantUC := usecase.NewCreateRestaurantUseCase(restaurantRepo)
+	createRestaurantUC := usecase.NewCreateRestaurantUseCase(restaurantRepo, txRunner)
 	listRestaurantsUC := usecase.NewListRestaurantsUseCase(restaurantRepo)
+	listNearbyRestaurantsUC := usecase.NewListNearbyRestaurantsUseCase(restaurantRepo)
+	getRestaurantFacetsUC := usecase.NewGetRestaurantFacetsUseCase(restaurantRepo)
 	getRestaurantBySlugUC := usecase.NewGetRestaurantBySlugUseCase(restaurantRepo)
-	openRestaurantUC := usecase.NewOpenRestaurantUseCase(restaurantRepo)
-	closeRestaurantUC := usecase.NewCloseRestaurantUseCase(restaurantRepo)
-	updateOpeningHoursUC := usecase.NewUpdateOpeningHoursUseCase(restaurantRepo)
-	updatePaymentMethodsUC := usecase.NewUpdatePaymentMethodsUseCase(restaurantRepo)
-
-	// Initialize handler
+	openRestaurantUC := usecase.NewOpenRestaurantUseCase(restaurantRepo, txRunner)
+	closeRestaurantUC := usecase.NewCloseRestaurantUseCase(restaurantRepo, txRunner)
+	updateOpeningHoursUC := usecase.NewUpdateOpeningHoursUseCase(restaurantRepo, txRunner)
+	updatePaymentMethodsUC := usecase.NewUpdatePaymentMethodsUseCase(restaurantRepo, txRunner)
+	updateProfileUC := usecase.NewUpdateRestaurantProfileUseCase(restaurantRepo, txRunner)
+	saveAddressUC := usecase.NewSaveRestaurantAddressUseCase(restaurantRepo, txRunner)
+	getAddressUC := usecase.NewGetRestaurantAddressUseCase(restaurantRepo)
+	suspendRestaurantUC := usecase.NewSuspendRestaurantUseCase(restaurantRepo, txRunner)
+	reinstateRestaurantUC := usecase.NewReinstateRestaurantUseCase(restaurantRepo, txRunner)
+	listStatusHistoryUC := usecase.NewListStatusHistoryUseCase(restaurantRepo)
+	saveSpecialHoursUC := usecase.NewSaveSpecialHoursUseCase(restaurantRepo, txRunner)
+	listSpecialHoursUC := usecase.NewListSpecialHoursUseCase(restaurantRepo)
+	deleteSpecialHoursUC := usecase.NewDeleteSpecialHoursUseCase(restaurantRepo)
+	updateDeliveryAreasUC := usecase.NewUpdateDeliveryAreasUseCase(restaurantRepo, txRunner)
+	checkDeliveryUC := usecase.NewCheckDeliveryUseCase(restaurantRepo)
+	listCategoriesUC := usecase.NewListCategoriesUseCase(restaurantRepo)
+	createCategoryUC := usecase.NewCreateCategoryUseCase(restaurantRepo)
+	updateCategoryUC := usecase.NewUpdateCategoryUseCase(restaurantRepo, txRunner)
+	deleteCategoryUC := usecase.NewDeleteCategoryUseCase(restaurantRepo)
+	getMenuUC := usecase.NewGetMenuUseCase(restaurantRepo)
+	createMenuSectionUC := usecase.NewCreateMenuSectionUseCase(restaurantRepo)
+	updateMenuSectionUC := usecase.NewUpdateMenuSectionUseCase(restaurantRepo)
+	deleteMenuSectionUC := usecase.NewDeleteMenuSectionUseCase(restaurantRepo)
+	createMenuItemUC := usecase.NewCreateMenuItemUseCase(restaurantRepo)
+	updateMenuItemUC := usecase.NewUpdateMenuItemUseCase(restaurantRepo)
+	deleteMenuItemUC := usecase.NewDeleteMenuItemUseCase(restaurantRepo)
+	updateMenuOptionGroupsUC := usecase.NewUpdateMenuOptionGroupsUseCase(restaurantRepo, txRunner)
+	updateMenuSectionAvailabilityUC := usecase.NewUpdateMenuSectionAvailabilityUseCase(restaurantRepo, txRunner)
+	updateMenuItemAvailabilityUC := usecase.NewUpdateMenuItemAvailabilityUseCase(restaurantRepo, txRunner)
+
+	// Initialize handlers
 	restaurantHandler := handler.NewRestaurantHandler(
 		createRestaurantUC,
 		listRestaurantsUC,
@@ -51,6 +79,40 @@ func main() {
 		closeRestaurantUC,
 		updateOpeningHoursUC,
 		updatePaymentMethodsUC,
+		updateProfileUC,
+		saveAddressUC,
+		getAddressUC,
+		suspendRestaurantUC,
+		reinstateRestaurantUC,
+		listStatusHistoryUC,
+	)
+	specialHoursHandler := handler.NewSpecialHoursHandler(
+		saveSpecialHoursUC,
+		listSpecialHoursUC,
+		deleteSpecialHoursUC,
+	)
+	searchHandler := handler.NewSearchHandler(listNearbyRestaurantsUC, getRestaurantFacetsUC)
+	deliveryAreaHandler := handler.NewDeliveryAreaHandler(
+		updateDeliveryAreasUC,
+		checkDeliveryUC,
+	)
+	categoryHandler := handler.NewCategoryHandler(
+		listCategoriesUC,
+		createCategoryUC,
+		updateCategoryUC,
+		deleteCategoryUC,
+	)
+	menuHandler := handler.NewMenuHandler(
+		getMenuUC,
+		createMenuSectionUC,
+		updateMenuSectionUC,
+		deleteMenuSectionUC,
+		createMenuItemUC,
+		updateMenuItemUC,
+		deleteMenuItemUC,
+		updateMenuOptionGroupsUC,
+		updateMenuSectionAvailabilityUC,
+		updateMenuItemAvailabilityUC,
 	)
 
 	// Initialize Echo
@@ -60,6 +122,7 @@ func main() {
 	e.Use(middleware.Logger())
 	e.Use(middleware.Recover())
 	e.Use(middleware.CORS())
+	e.Use(handler.ActorMiddleware(os.Getenv("ADMIN_API_TOKEN")))
 
 	// Health check endpoint
 	e.GET("/health", func(c echo.Context) error {
@@ -72,11 +135,42 @@ func main() {
 	// Restaurant routes
 	e.POST("/restaurants", restaurantHandler.CreateRestaurant)
 	e.GET("/restaurants", restaurantHandler.ListRestaurants)
+	e.GET("/restaurants/nearby", searchHandler.ListNearbyRestaurants)
+	e.GET("/restaurants/facets", searchHandler.GetRestaurantFacets)
 	e.GET("/restaurants/:slug", restaurantHandler.GetRestaurantBySlug)
+	e.PATCH("/restaurants/:id", restaurantHandler.UpdateRestaurantProfile)
 	e.PATCH("/restaurants/:id/open", restaurantHandler.OpenRestaurant)
 	e.PATCH("/restaurants/:id/close", restaurantHandler.CloseRestaurant)
+	e.PATCH("/restaurants/:id/suspend", restaurantHandler.SuspendRestaurant)
+	e.PATCH("/restaurants/:id/reinstate", restaurantHandler.ReinstateRestaurant)
+	e.GET("/restaurants/:id/status-history", restaurantHandler.ListStatusHistory)
 	e.PUT("/restaurants/:id/hours", restaurantHandler.UpdateOpeningHours)
 	e.PUT("/restaurants/:id/payments", restaurantHandler.UpdatePaymentMethods)
+	e.PUT("/restaurants/:id/address", restaurantHandler.SaveRestaurantAddress)
+	e.GET("/restaurants/:id/address", restaurantHandler.GetRestaurantAddress)
+	e.GET("/restaurants/:id/special-hours", specialHoursHandler.ListSpecialHours)
+	e.PUT("/restaurants/:id/special-hours/:date", specialHoursHandler.SaveSpecialHours)
+	e.DELETE("/restaurants/:id/special-hours/:date", specialHoursHandler.DeleteSpecialHours)
+	e.PUT("/restaurants/:id/delivery-areas", deliveryAreaHandler.UpdateDeliveryAreas)
+	e.GET("/restaurants/:slug/delivers-to", deliveryAreaHandler.CheckDelivery)
+
+	// Menu routes
+	e.GET("/restaurants/:slug/menu", menuHandler.GetMenu)
+	e.POST("/restaurants/:id/menu/sections", menuHandler.CreateMenuSection)
+	e.PUT("/restaurants/:id/menu/sections/:section_id", menuHandler.UpdateMenuSection)
+	e.DELETE("/restaurants/:id/menu/sections/:section_id", menuHandler.DeleteMenuSection)
+	e.PUT("/restaurants/:id/menu/sections/:section_id/availability", menuHandler.UpdateMenuSectionAvailability)
+	e.POST("/restaurants/:id/menu/sections/:section_id/items", menuHandler.CreateMenuItem)
+	e.PUT("/restaurants/:id/menu/items/:item_id", menuHandler.UpdateMenuItem)
+	e.DELETE("/restaurants/:id/menu/items/:item_id", menuHandler.DeleteMenuItem)
+	e.PUT("/restaurants/:id/menu/items/:item_id/option-groups", menuHandler.UpdateMenuOptionGroups)
+	e.PUT("/restaurants/:id/menu/items/:item_id/availability", menuHandler.UpdateMenuItemAvailability)
+
+	// Category routes
+	e.GET("/categories", categoryHandler.ListCategories)
+	e.POST("/categories", categoryHandler.CreateCategory)
+	e.PUT("/categories/:id", categoryHandler.UpdateCategory)
+	e.DELETE("/categories/:id", categoryHandler.DeleteCategory)
 
 	// Start server
 	port := os.Getenv("PORT")
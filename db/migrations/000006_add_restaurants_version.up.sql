@@ -0,0 +1 @@
+ALTER TABLE restaurants ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
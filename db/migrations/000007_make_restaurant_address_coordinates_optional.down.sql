@@ -0,0 +1,4 @@
+UPDATE restaurant_addresses SET lat = 0 WHERE lat IS NULL;
+UPDATE restaurant_addresses SET lng = 0 WHERE lng IS NULL;
+ALTER TABLE restaurant_addresses ALTER COLUMN lat SET NOT NULL;
+ALTER TABLE restaurant_addresses ALTER COLUMN lng SET NOT NULL;
@@ -0,0 +1,3 @@
+-- R3: latitude e longitude são opcionais no DRAFT e exigidas apenas para abrir o restaurante
+ALTER TABLE restaurant_addresses ALTER COLUMN lat DROP NOT NULL;
+ALTER TABLE restaurant_addresses ALTER COLUMN lng DROP NOT NULL;
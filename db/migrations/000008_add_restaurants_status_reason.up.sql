@@ -0,0 +1 @@
+ALTER TABLE restaurants ADD COLUMN status_reason TEXT;
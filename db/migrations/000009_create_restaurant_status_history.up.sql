@@ -0,0 +1,13 @@
+CREATE TABLE restaurant_status_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    event VARCHAR(20) NOT NULL,
+    reason TEXT,
+    actor_id VARCHAR(255),
+    actor_role VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_restaurant_status_history_restaurant_id ON restaurant_status_history(restaurant_id, created_at DESC);
@@ -0,0 +1,2 @@
+-- Fuso horário IANA explícito; NULL = inferido pela UF do endereço
+ALTER TABLE restaurants ADD COLUMN timezone VARCHAR(64);
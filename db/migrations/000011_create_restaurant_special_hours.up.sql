@@ -0,0 +1,14 @@
+-- Exceções de horário por data: uma linha por intervalo; opens_at/closes_at NULL = fechado o dia todo
+CREATE TABLE restaurant_special_hours (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    date DATE NOT NULL,
+    opens_at INTEGER CHECK (opens_at >= 0 AND opens_at < 1440),
+    closes_at INTEGER CHECK (closes_at >= 0 AND closes_at < 1440),
+    note TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK ((opens_at IS NULL) = (closes_at IS NULL))
+);
+
+CREATE INDEX idx_restaurant_special_hours_date ON restaurant_special_hours(restaurant_id, date);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_restaurant_addresses_state_city;
+DROP INDEX IF EXISTS idx_restaurants_category;
+DROP INDEX IF EXISTS idx_restaurants_status_created_at;
@@ -0,0 +1,3 @@
+CREATE INDEX idx_restaurants_status_created_at ON restaurants(status, created_at DESC);
+CREATE INDEX idx_restaurants_category ON restaurants(lower(category));
+CREATE INDEX idx_restaurant_addresses_state_city ON restaurant_addresses(state, lower(city));
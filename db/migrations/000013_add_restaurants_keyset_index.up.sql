@@ -0,0 +1,2 @@
+-- Paginação por cursor: ordenação estável por (created_at, id)
+CREATE INDEX idx_restaurants_created_at_id ON restaurants(created_at DESC, id DESC);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_restaurant_addresses_lat_lng;
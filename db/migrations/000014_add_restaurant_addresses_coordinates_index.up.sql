@@ -0,0 +1,2 @@
+CREATE INDEX idx_restaurant_addresses_lat_lng ON restaurant_addresses(lat, lng)
+WHERE lat IS NOT NULL AND lng IS NOT NULL;
@@ -0,0 +1,16 @@
+-- Áreas de entrega: raio em metros ao redor do endereço ou polígono GeoJSON (avaliado na aplicação)
+CREATE TABLE restaurant_delivery_areas (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    kind VARCHAR(16) NOT NULL CHECK (kind IN ('RADIUS', 'POLYGON')),
+    radius_m INTEGER CHECK (radius_m > 0),
+    polygon JSONB,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK (
+        (kind = 'RADIUS' AND radius_m IS NOT NULL AND polygon IS NULL) OR
+        (kind = 'POLYGON' AND polygon IS NOT NULL AND radius_m IS NULL)
+    )
+);
+
+CREATE INDEX idx_restaurant_delivery_areas_restaurant_id ON restaurant_delivery_areas(restaurant_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_restaurants_search_vector;
+ALTER TABLE restaurants DROP COLUMN IF EXISTS search_vector;
+DROP TEXT SEARCH CONFIGURATION IF EXISTS portuguese_unaccent;
+DROP EXTENSION IF EXISTS unaccent;
@@ -0,0 +1,14 @@
+-- Busca textual em português sem acentos: "pizzaria joao" encontra "Pizzaria do João"
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+CREATE TEXT SEARCH CONFIGURATION portuguese_unaccent (COPY = portuguese);
+ALTER TEXT SEARCH CONFIGURATION portuguese_unaccent
+    ALTER MAPPING FOR hword, hword_part, word WITH unaccent, portuguese_stem;
+
+ALTER TABLE restaurants ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('portuguese_unaccent'::regconfig, coalesce(name, '')), 'A') ||
+    setweight(to_tsvector('portuguese_unaccent'::regconfig, coalesce(category, '')), 'B') ||
+    setweight(to_tsvector('portuguese_unaccent'::regconfig, coalesce(description, '')), 'C')
+) STORED;
+
+CREATE INDEX idx_restaurants_search_vector ON restaurants USING GIN (search_vector);
@@ -0,0 +1,61 @@
+-- Taxonomia de categorias: um restaurante pode ter várias (a de menor position é a principal)
+CREATE TABLE categories (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    slug VARCHAR(100) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    icon VARCHAR(255),
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_categories_slug ON categories(slug);
+
+CREATE TABLE restaurant_categories (
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    category_id UUID NOT NULL REFERENCES categories(id) ON DELETE RESTRICT,
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (restaurant_id, category_id)
+);
+
+CREATE INDEX idx_restaurant_categories_category_id ON restaurant_categories(category_id);
+
+-- Migração dos valores livres de restaurants.category
+-- "Pizza", "pizza" e " PIZZA " viram o slug "pizza"; "Pizzas" também, quando "pizza" existe
+CREATE FUNCTION pg_temp.category_slug(value TEXT) RETURNS TEXT AS $$
+    SELECT trim(BOTH '-' FROM regexp_replace(lower(unaccent(trim(value))), '[^a-z0-9]+', '-', 'g'))
+$$ LANGUAGE SQL;
+
+CREATE TEMPORARY TABLE legacy_categories AS
+SELECT id AS restaurant_id, trim(category) AS name, pg_temp.category_slug(category) AS slug
+FROM restaurants
+WHERE pg_temp.category_slug(category) <> '';
+
+UPDATE legacy_categories l
+SET slug = left(l.slug, -1)
+WHERE l.slug LIKE '%s'
+  AND EXISTS (SELECT 1 FROM legacy_categories s WHERE s.slug = left(l.slug, -1));
+
+-- O nome exibido é a grafia mais frequente da forma canônica
+INSERT INTO categories (slug, name, position)
+SELECT slug,
+       mode() WITHIN GROUP (ORDER BY name),
+       row_number() OVER (ORDER BY mode() WITHIN GROUP (ORDER BY name))
+FROM legacy_categories
+WHERE pg_temp.category_slug(name) = slug
+GROUP BY slug;
+
+INSERT INTO restaurant_categories (restaurant_id, category_id)
+SELECT l.restaurant_id, c.id
+FROM legacy_categories l
+JOIN categories c ON c.slug = l.slug;
+
+-- restaurants.category passa a guardar o nome da categoria principal (usado na busca textual)
+UPDATE restaurants r
+SET category = c.name
+FROM restaurant_categories rc
+JOIN categories c ON c.id = rc.category_id
+WHERE rc.restaurant_id = r.id;
+
+DROP TABLE legacy_categories;
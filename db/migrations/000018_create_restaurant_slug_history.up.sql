@@ -0,0 +1,7 @@
+CREATE TABLE restaurant_slug_history (
+    slug VARCHAR(255) PRIMARY KEY,
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    retired_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_restaurant_slug_history_restaurant_id ON restaurant_slug_history(restaurant_id);
@@ -0,0 +1,31 @@
+-- Cardápio: seções ordenadas por position, cada uma com seus itens
+CREATE TABLE menu_sections (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    position INTEGER NOT NULL DEFAULT 0 CHECK (position >= 0),
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_menu_sections_restaurant_id ON menu_sections(restaurant_id, position);
+
+-- Itens do cardápio; price em centavos (Money Pattern)
+CREATE TABLE menu_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    section_id UUID NOT NULL REFERENCES menu_sections(id) ON DELETE CASCADE,
+    name VARCHAR(150) NOT NULL,
+    description TEXT,
+    price BIGINT NOT NULL CHECK (price >= 0),
+    image_url TEXT,
+    position INTEGER NOT NULL DEFAULT 0 CHECK (position >= 0),
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_menu_items_restaurant_id ON menu_items(restaurant_id);
+CREATE INDEX idx_menu_items_section_id ON menu_items(section_id, position);
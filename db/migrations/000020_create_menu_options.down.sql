@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS menu_options;
+DROP TABLE IF EXISTS menu_option_groups;
@@ -0,0 +1,34 @@
+-- Grupos de opções de um item (ex: "Tamanho", "Adicionais")
+-- Grupo opcional pode ser ignorado; se escolhido, respeita min/max
+CREATE TABLE menu_option_groups (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    item_id UUID NOT NULL REFERENCES menu_items(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    min_selections INTEGER NOT NULL DEFAULT 0 CHECK (min_selections >= 0),
+    max_selections INTEGER NOT NULL DEFAULT 1 CHECK (max_selections >= 1),
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    position INTEGER NOT NULL DEFAULT 0 CHECK (position >= 0),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK (min_selections <= max_selections)
+);
+
+CREATE INDEX idx_menu_option_groups_restaurant_id ON menu_option_groups(restaurant_id);
+CREATE INDEX idx_menu_option_groups_item_id ON menu_option_groups(item_id, position);
+
+-- Opções de um grupo; price_delta em centavos, pode ser negativo (ex: tamanho menor)
+CREATE TABLE menu_options (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    group_id UUID NOT NULL REFERENCES menu_option_groups(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    price_delta BIGINT NOT NULL DEFAULT 0,
+    position INTEGER NOT NULL DEFAULT 0 CHECK (position >= 0),
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_menu_options_restaurant_id ON menu_options(restaurant_id);
+CREATE INDEX idx_menu_options_group_id ON menu_options(group_id, position);
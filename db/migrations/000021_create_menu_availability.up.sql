@@ -0,0 +1,18 @@
+-- Janelas semanais de venda de seções e itens do cardápio (mesmo formato de restaurant_opening_hours)
+-- Cada janela pertence a exatamente uma seção ou um item; sem janelas, está sempre disponível
+CREATE TABLE menu_availability_windows (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    section_id UUID REFERENCES menu_sections(id) ON DELETE CASCADE,
+    item_id UUID REFERENCES menu_items(id) ON DELETE CASCADE,
+    weekday INTEGER NOT NULL CHECK (weekday >= 0 AND weekday <= 6),
+    opens_at INTEGER NOT NULL CHECK (opens_at >= 0 AND opens_at < 1440),
+    closes_at INTEGER NOT NULL CHECK (closes_at >= 0 AND closes_at < 1440),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK (num_nonnulls(section_id, item_id) = 1)
+);
+
+CREATE INDEX idx_menu_availability_windows_restaurant_id ON menu_availability_windows(restaurant_id);
+CREATE INDEX idx_menu_availability_windows_section_id ON menu_availability_windows(section_id) WHERE section_id IS NOT NULL;
+CREATE INDEX idx_menu_availability_windows_item_id ON menu_availability_windows(item_id) WHERE item_id IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP FUNCTION IF EXISTS filtered_restaurants(TEXT, TEXT, BOOLEAN, BOOLEAN, BIGINT, INTEGER, TEXT, TEXT, BOOLEAN, TEXT, TIMESTAMPTZ);
+DROP FUNCTION IF EXISTS restaurant_is_open(UUID, TEXT, TIMESTAMPTZ);
+DROP FUNCTION IF EXISTS restaurant_timezone(TEXT, TEXT);
@@ -0,0 +1,116 @@
+-- Fuso efetivo do restaurante: explícito, da UF do endereço ou o padrão
+-- Espelha domain.Restaurant.TimezoneName; os dois mapeamentos devem ser mantidos iguais
+CREATE FUNCTION restaurant_timezone(p_timezone TEXT, p_state TEXT) RETURNS TEXT AS $$
+    SELECT COALESCE(p_timezone, CASE p_state
+        WHEN 'AC' THEN 'America/Rio_Branco'
+        WHEN 'AL' THEN 'America/Maceio'
+        WHEN 'AM' THEN 'America/Manaus'
+        WHEN 'AP' THEN 'America/Belem'
+        WHEN 'BA' THEN 'America/Bahia'
+        WHEN 'CE' THEN 'America/Fortaleza'
+        WHEN 'DF' THEN 'America/Sao_Paulo'
+        WHEN 'ES' THEN 'America/Sao_Paulo'
+        WHEN 'GO' THEN 'America/Sao_Paulo'
+        WHEN 'MA' THEN 'America/Fortaleza'
+        WHEN 'MG' THEN 'America/Sao_Paulo'
+        WHEN 'MS' THEN 'America/Campo_Grande'
+        WHEN 'MT' THEN 'America/Cuiaba'
+        WHEN 'PA' THEN 'America/Belem'
+        WHEN 'PB' THEN 'America/Fortaleza'
+        WHEN 'PE' THEN 'America/Recife'
+        WHEN 'PI' THEN 'America/Fortaleza'
+        WHEN 'PR' THEN 'America/Sao_Paulo'
+        WHEN 'RJ' THEN 'America/Sao_Paulo'
+        WHEN 'RN' THEN 'America/Fortaleza'
+        WHEN 'RO' THEN 'America/Porto_Velho'
+        WHEN 'RR' THEN 'America/Boa_Vista'
+        WHEN 'RS' THEN 'America/Sao_Paulo'
+        WHEN 'SC' THEN 'America/Sao_Paulo'
+        WHEN 'SE' THEN 'America/Maceio'
+        WHEN 'SP' THEN 'America/Sao_Paulo'
+        WHEN 'TO' THEN 'America/Araguaina'
+    END, 'America/Sao_Paulo')
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- Informa se o restaurante está dentro de um intervalo de funcionamento no instante p_at
+-- Espelha domain.Restaurant.CalculateIsOpen (sem a checagem de status): intervalos que começam
+-- ontem ou hoje no fuso do restaurante, com a exceção da data substituindo os horários semanais
+-- e closes_at <= opens_at terminando no dia seguinte
+CREATE FUNCTION restaurant_is_open(p_restaurant_id UUID, p_timezone TEXT, p_at TIMESTAMPTZ) RETURNS BOOLEAN AS $$
+    WITH local AS (
+        SELECT p_at AT TIME ZONE restaurant_timezone(p_timezone, (
+            SELECT a.state FROM restaurant_addresses a WHERE a.restaurant_id = p_restaurant_id
+        )) AS now_local
+    ),
+    days AS (
+        SELECT local.now_local::date - o.days_ago AS day, local.now_local
+        FROM local, (VALUES (0), (1)) AS o(days_ago)
+    ),
+    intervals AS (
+        SELECT days.day, days.now_local, s.opens_at, s.closes_at
+        FROM days
+        JOIN restaurant_special_hours s ON s.restaurant_id = p_restaurant_id AND s.date = days.day
+        WHERE s.opens_at IS NOT NULL
+        UNION ALL
+        SELECT days.day, days.now_local, h.opens_at, h.closes_at
+        FROM days
+        JOIN restaurant_opening_hours h ON h.restaurant_id = p_restaurant_id AND h.weekday = extract(dow FROM days.day)
+        WHERE NOT EXISTS (
+            SELECT 1 FROM restaurant_special_hours s
+            WHERE s.restaurant_id = p_restaurant_id AND s.date = days.day
+        )
+    )
+    SELECT EXISTS (
+        SELECT 1 FROM intervals
+        WHERE opens_at <> closes_at
+          AND now_local >= day + make_interval(mins => opens_at)
+          AND now_local < day + make_interval(mins => CASE WHEN closes_at > opens_at THEN closes_at ELSE closes_at + 1440 END)
+    )
+$$ LANGUAGE SQL STABLE;
+
+-- Predicados compartilhados pela listagem, contagem, facetas e busca (parâmetro NULL desativa o filtro)
+-- SQL, STABLE e sem STRICT: o planner expande a função na query que a chama (inlining),
+-- então os predicados continuam usando os índices de restaurants
+CREATE FUNCTION filtered_restaurants(
+    p_category TEXT,
+    p_status TEXT,
+    p_supports_delivery BOOLEAN,
+    p_supports_pickup BOOLEAN,
+    p_max_delivery_fee BIGINT,
+    p_max_preparation_time INTEGER,
+    p_city TEXT,
+    p_state TEXT,
+    p_has_delivery_area BOOLEAN,
+    p_query TEXT,
+    p_open_at TIMESTAMPTZ
+) RETURNS SETOF restaurants AS $$
+    SELECT * FROM restaurants r
+    WHERE (p_category IS NULL OR EXISTS (
+          SELECT 1 FROM restaurant_categories rc
+          JOIN categories c ON c.id = rc.category_id
+          WHERE rc.restaurant_id = r.id
+            AND (c.slug = lower(p_category) OR lower(c.name) = lower(p_category))
+      ))
+      AND (p_status IS NULL OR r.status = p_status)
+      AND (p_supports_delivery IS NULL OR r.supports_delivery = p_supports_delivery)
+      AND (p_supports_pickup IS NULL OR r.supports_pickup = p_supports_pickup)
+      AND (p_max_delivery_fee IS NULL OR r.delivery_fee <= p_max_delivery_fee)
+      AND (p_max_preparation_time IS NULL OR r.preparation_time_min <= p_max_preparation_time)
+      AND (p_city IS NULL OR EXISTS (
+          SELECT 1 FROM restaurant_addresses a
+          WHERE a.restaurant_id = r.id AND lower(a.city) = lower(p_city)
+      ))
+      AND (p_state IS NULL OR EXISTS (
+          SELECT 1 FROM restaurant_addresses a
+          WHERE a.restaurant_id = r.id AND a.state = p_state
+      ))
+      AND (p_has_delivery_area IS NULL OR (r.supports_delivery AND EXISTS (
+          SELECT 1 FROM restaurant_delivery_areas d
+          WHERE d.restaurant_id = r.id
+      )) = p_has_delivery_area)
+      AND (p_query IS NULL
+           OR r.search_vector @@ websearch_to_tsquery('portuguese_unaccent', p_query))
+      -- Aberto agora: apenas restaurantes OPEN dentro de um intervalo no próprio fuso
+      AND (p_open_at IS NULL
+           OR (r.status = 'OPEN' AND restaurant_is_open(r.id, r.timezone, p_open_at)))
+$$ LANGUAGE SQL STABLE;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_restaurants_name_id;
+DROP INDEX IF EXISTS idx_restaurants_preparation_time_min_id;
+DROP INDEX IF EXISTS idx_restaurants_min_order_value_id;
+DROP INDEX IF EXISTS idx_restaurants_delivery_fee_id;
+DROP INDEX IF EXISTS idx_restaurants_total_reviews_id;
+DROP INDEX IF EXISTS idx_restaurants_rating_id;
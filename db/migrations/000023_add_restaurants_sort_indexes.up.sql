@@ -0,0 +1,7 @@
+-- Ordenação explícita da listagem: keyset por (campo, id), percorrido nos dois sentidos
+CREATE INDEX idx_restaurants_rating_id ON restaurants(rating, id);
+CREATE INDEX idx_restaurants_total_reviews_id ON restaurants(total_reviews, id);
+CREATE INDEX idx_restaurants_delivery_fee_id ON restaurants(delivery_fee, id);
+CREATE INDEX idx_restaurants_min_order_value_id ON restaurants(min_order_value, id);
+CREATE INDEX idx_restaurants_preparation_time_min_id ON restaurants(preparation_time_min, id);
+CREATE INDEX idx_restaurants_name_id ON restaurants(name, id);
@@ -0,0 +1,59 @@
+-- name: AddRestaurantCategory :exec
+INSERT INTO restaurant_categories (
+    restaurant_id, category_id, position
+) VALUES (
+    $1, $2, $3
+);
+
+-- name: CategorySlugExists :one
+-- Verifica se o slug já pertence a outra categoria (id diferente do informado)
+SELECT EXISTS (
+    SELECT 1 FROM categories WHERE slug = $1 AND id <> $2
+);
+
+-- name: CreateCategory :one
+INSERT INTO categories (
+    slug, name, icon, position
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- name: DeleteCategory :execrows
+-- Categorias com restaurantes são protegidas pela FK (ON DELETE RESTRICT)
+DELETE FROM categories WHERE id = $1;
+
+-- name: DeleteRestaurantCategories :exec
+DELETE FROM restaurant_categories WHERE restaurant_id = $1;
+
+-- name: GetCategoriesByRefs :many
+-- Resolve categorias informadas por ID ou slug
+SELECT * FROM categories
+WHERE id = ANY(sqlc.arg('ids')::uuid[]) OR slug = ANY(sqlc.arg('slugs')::text[]);
+
+-- name: GetCategoriesByRestaurants :many
+SELECT rc.restaurant_id, sqlc.embed(categories)
+FROM restaurant_categories rc
+JOIN categories ON categories.id = rc.category_id
+WHERE rc.restaurant_id = ANY(sqlc.arg('restaurant_ids')::uuid[])
+ORDER BY rc.restaurant_id, rc.position, categories.name;
+
+-- name: GetCategoryByID :one
+SELECT * FROM categories WHERE id = $1;
+
+-- name: ListCategories :many
+SELECT * FROM categories
+ORDER BY position, name;
+
+-- name: SyncPrimaryCategoryName :exec
+-- Mantém restaurants.category (nome da categoria principal, usado na busca) em dia com a taxonomia
+UPDATE restaurants r
+SET category = c.name
+FROM restaurant_categories rc
+JOIN categories c ON c.id = rc.category_id
+WHERE rc.restaurant_id = r.id AND rc.category_id = $1 AND rc.position = 0;
+
+-- name: UpdateCategory :one
+UPDATE categories
+SET slug = $2, name = $3, icon = $4, position = $5, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,52 @@
+-- name: CreateMenuSection :one
+INSERT INTO menu_sections (
+    restaurant_id, name, description, position, active
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetMenuSection :one
+SELECT * FROM menu_sections
+WHERE id = $1 AND restaurant_id = $2;
+
+-- name: ListMenuSections :many
+SELECT * FROM menu_sections
+WHERE restaurant_id = $1
+ORDER BY position, created_at, id;
+
+-- name: UpdateMenuSection :one
+UPDATE menu_sections
+SET name = $3, description = $4, position = $5, active = $6, updated_at = NOW()
+WHERE id = $1 AND restaurant_id = $2
+RETURNING *;
+
+-- name: DeleteMenuSection :execrows
+DELETE FROM menu_sections
+WHERE id = $1 AND restaurant_id = $2;
+
+-- name: CreateMenuItem :one
+INSERT INTO menu_items (
+    restaurant_id, section_id, name, description, price, image_url, position, active
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: GetMenuItem :one
+SELECT * FROM menu_items
+WHERE id = $1 AND restaurant_id = $2;
+
+-- name: ListMenuItems :many
+SELECT * FROM menu_items
+WHERE restaurant_id = $1
+ORDER BY position, created_at, id;
+
+-- name: UpdateMenuItem :one
+UPDATE menu_items
+SET section_id = $3, name = $4, description = $5, price = $6, image_url = $7,
+    position = $8, active = $9, updated_at = NOW()
+WHERE id = $1 AND restaurant_id = $2
+RETURNING *;
+
+-- name: DeleteMenuItem :execrows
+DELETE FROM menu_items
+WHERE id = $1 AND restaurant_id = $2;
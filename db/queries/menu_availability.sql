@@ -0,0 +1,24 @@
+-- name: CreateMenuAvailabilityWindow :one
+INSERT INTO menu_availability_windows (
+    restaurant_id, section_id, item_id, weekday, opens_at, closes_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: DeleteMenuSectionAvailability :exec
+DELETE FROM menu_availability_windows
+WHERE section_id = $1 AND restaurant_id = $2;
+
+-- name: DeleteMenuItemAvailability :exec
+DELETE FROM menu_availability_windows
+WHERE item_id = $1 AND restaurant_id = $2;
+
+-- name: ListMenuSectionAvailability :many
+SELECT * FROM menu_availability_windows
+WHERE restaurant_id = $1 AND section_id IS NOT NULL
+ORDER BY weekday, opens_at;
+
+-- name: ListMenuItemAvailability :many
+SELECT * FROM menu_availability_windows
+WHERE restaurant_id = $1 AND item_id IS NOT NULL
+ORDER BY weekday, opens_at;
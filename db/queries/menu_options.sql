@@ -0,0 +1,57 @@
+-- name: CreateMenuOptionGroup :one
+INSERT INTO menu_option_groups (
+    restaurant_id, item_id, name, min_selections, max_selections, required, position
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: CreateMenuOption :one
+INSERT INTO menu_options (
+    restaurant_id, group_id, name, price_delta, position, active
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: DeleteMenuOptionGroupsByItemExcept :exec
+-- Remove os grupos do item fora de keep_ids; as opções são removidas em cascata
+DELETE FROM menu_option_groups
+WHERE item_id = sqlc.arg('item_id') AND restaurant_id = sqlc.arg('restaurant_id')
+  AND id <> ALL(sqlc.arg('keep_ids')::uuid[]);
+
+-- name: DeleteMenuOptionsByGroupExcept :exec
+DELETE FROM menu_options
+WHERE group_id = sqlc.arg('group_id') AND restaurant_id = sqlc.arg('restaurant_id')
+  AND id <> ALL(sqlc.arg('keep_ids')::uuid[]);
+
+-- name: ListMenuOptionGroups :many
+SELECT * FROM menu_option_groups
+WHERE restaurant_id = $1
+ORDER BY position, created_at, id;
+
+-- name: ListMenuOptions :many
+SELECT * FROM menu_options
+WHERE restaurant_id = $1
+ORDER BY position, created_at, id;
+
+-- name: ListMenuOptionGroupsByItem :many
+SELECT * FROM menu_option_groups
+WHERE restaurant_id = $1 AND item_id = $2
+ORDER BY position, created_at, id;
+
+-- name: ListMenuOptionsByItem :many
+SELECT o.* FROM menu_options o
+JOIN menu_option_groups g ON g.id = o.group_id
+WHERE g.restaurant_id = $1 AND g.item_id = $2
+ORDER BY o.position, o.created_at, o.id;
+
+-- name: UpdateMenuOptionGroup :one
+UPDATE menu_option_groups
+SET name = $4, min_selections = $5, max_selections = $6, required = $7, position = $8, updated_at = NOW()
+WHERE id = $1 AND restaurant_id = $2 AND item_id = $3
+RETURNING *;
+
+-- name: UpdateMenuOption :one
+UPDATE menu_options
+SET name = $4, price_delta = $5, position = $6, active = $7, updated_at = NOW()
+WHERE id = $1 AND restaurant_id = $2 AND group_id = $3
+RETURNING *;
@@ -0,0 +1,19 @@
+-- name: CreateDeliveryArea :one
+INSERT INTO restaurant_delivery_areas (
+    restaurant_id, kind, radius_m, polygon
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- name: DeleteDeliveryAreasByRestaurant :exec
+DELETE FROM restaurant_delivery_areas WHERE restaurant_id = $1;
+
+-- name: GetDeliveryAreasByRestaurant :many
+SELECT * FROM restaurant_delivery_areas
+WHERE restaurant_id = $1
+ORDER BY created_at, id;
+
+-- name: GetDeliveryAreasByRestaurants :many
+SELECT * FROM restaurant_delivery_areas
+WHERE restaurant_id = ANY(sqlc.arg('restaurant_ids')::uuid[])
+ORDER BY restaurant_id, created_at, id;
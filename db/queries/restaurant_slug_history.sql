@@ -0,0 +1,28 @@
+-- name: AddRestaurantSlugHistory :exec
+INSERT INTO restaurant_slug_history (slug, restaurant_id) VALUES ($1, $2);
+
+-- name: DeleteRestaurantSlugHistory :exec
+-- Libera um slug aposentado quando o próprio restaurante volta a usá-lo
+DELETE FROM restaurant_slug_history WHERE slug = $1 AND restaurant_id = $2;
+
+-- name: GetCanonicalSlug :one
+-- Resolve um slug aposentado para o slug atual do restaurante
+SELECT r.slug FROM restaurant_slug_history h
+JOIN restaurants r ON r.id = h.restaurant_id
+WHERE h.slug = $1;
+
+-- name: ListTakenSlugs :many
+-- Slugs atuais e aposentados iguais à base ou com sufixo numérico (base-2, base-3, ...)
+SELECT slug FROM restaurants
+WHERE slug = $1 OR slug ~ ('^' || $1::text || '-[0-9]+$')
+UNION
+SELECT slug FROM restaurant_slug_history
+WHERE slug = $1 OR slug ~ ('^' || $1::text || '-[0-9]+$');
+
+-- name: RestaurantSlugExists :one
+-- Slugs aposentados continuam reservados para o restaurante que os usou
+SELECT EXISTS (
+    SELECT 1 FROM restaurants WHERE slug = $1 AND id <> $2
+    UNION ALL
+    SELECT 1 FROM restaurant_slug_history WHERE slug = $1 AND restaurant_id <> $2
+);
@@ -0,0 +1,24 @@
+-- name: CreateSpecialHour :one
+INSERT INTO restaurant_special_hours (
+    restaurant_id, date, opens_at, closes_at, note
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: DeleteSpecialHoursByDate :execrows
+DELETE FROM restaurant_special_hours
+WHERE restaurant_id = $1 AND date = $2;
+
+-- name: ListSpecialHoursByRestaurant :many
+SELECT * FROM restaurant_special_hours
+WHERE restaurant_id = $1
+  AND date >= sqlc.arg(from_date)
+  AND date <= sqlc.arg(to_date)
+ORDER BY date, opens_at NULLS FIRST;
+
+-- name: ListSpecialHoursByRestaurants :many
+SELECT * FROM restaurant_special_hours
+WHERE restaurant_id = ANY(sqlc.arg('restaurant_ids')::uuid[])
+  AND date >= sqlc.arg(from_date)
+  AND date <= sqlc.arg(to_date)
+ORDER BY restaurant_id, date, opens_at NULLS FIRST;
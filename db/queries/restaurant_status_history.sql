@@ -0,0 +1,16 @@
+-- name: CreateStatusHistoryEntry :one
+INSERT INTO restaurant_status_history (
+    restaurant_id, from_status, to_status, event, reason, actor_id, actor_role
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: ListStatusHistoryByRestaurant :many
+SELECT * FROM restaurant_status_history
+WHERE restaurant_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CountStatusHistoryByRestaurant :one
+SELECT COUNT(*) FROM restaurant_status_history
+WHERE restaurant_id = $1;
@@ -2,26 +2,271 @@
 INSERT INTO restaurants (
     name, slug, description, status, category, rating, total_reviews,
     delivery_fee, min_order_value, preparation_time_min,
-    supports_pickup, supports_delivery, logo_url, banner_url
+    supports_pickup, supports_delivery, logo_url, banner_url, timezone
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
 ) RETURNING *;
 
 -- name: GetRestaurantByID :one
 SELECT * FROM restaurants WHERE id = $1 LIMIT 1;
 
+-- name: GetRestaurantByIDForUpdate :one
+-- Trava a linha até o fim da transação; usado nas transições de status
+SELECT * FROM restaurants WHERE id = $1 LIMIT 1 FOR UPDATE;
+
 -- name: GetRestaurantBySlug :one
 SELECT * FROM restaurants WHERE slug = $1 LIMIT 1;
 
 -- name: ListRestaurants :many
-SELECT * FROM restaurants
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2;
+SELECT * FROM filtered_restaurants(
+    sqlc.narg('category')::text, sqlc.narg('status')::text,
+    sqlc.narg('supports_delivery')::boolean, sqlc.narg('supports_pickup')::boolean,
+    sqlc.narg('max_delivery_fee')::bigint, sqlc.narg('max_preparation_time')::integer,
+    sqlc.narg('city')::text, sqlc.narg('state')::text, sqlc.narg('has_delivery_area')::boolean,
+    NULL, CASE WHEN sqlc.narg('open_now')::boolean THEN now() END
+) restaurants
+WHERE (sqlc.narg('cursor_created_at')::timestamp IS NULL
+       OR (created_at, id) < (sqlc.narg('cursor_created_at'), sqlc.narg('cursor_id')::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: ListRestaurantsSorted :many
+-- Listagem com sort explícito: cada ramo atende um sort ("rating", "-rating", ...) com ORDER BY e
+-- keyset sobre a própria coluna, usando os índices (campo, id); só o ramo do sort pedido executa
+-- Empates são desfeitos por id na mesma direção do campo. cursor_number vem com o sinal da direção
+-- Restaurantes sem coordenadas ficam no fim da ordenação por distância (chave 1e15)
+WITH filtered AS NOT MATERIALIZED (
+    SELECT id, name, rating, total_reviews, delivery_fee, min_order_value, preparation_time_min
+    FROM filtered_restaurants(
+        sqlc.narg('category')::text, sqlc.narg('status')::text,
+        sqlc.narg('supports_delivery')::boolean, sqlc.narg('supports_pickup')::boolean,
+        sqlc.narg('max_delivery_fee')::bigint, sqlc.narg('max_preparation_time')::integer,
+        sqlc.narg('city')::text, sqlc.narg('state')::text, sqlc.narg('has_delivery_area')::boolean,
+        sqlc.narg('query')::text, CASE WHEN sqlc.narg('open_now')::boolean THEN now() END
+    ) restaurants
+), distances AS NOT MATERIALIZED (
+    SELECT f.id, d.distance_m,
+           COALESCE(d.distance_m, 1e15)::float8 AS near_key,
+           COALESCE(-d.distance_m, 1e15)::float8 AS far_key
+    FROM filtered f
+    LEFT JOIN LATERAL (
+        SELECT (2 * 6371000 * asin(least(1, sqrt(
+            power(sin(radians(a.lat - sqlc.narg('origin_lat')::float8) / 2), 2) +
+            cos(radians(sqlc.narg('origin_lat')::float8)) * cos(radians(a.lat)) *
+            power(sin(radians(a.lng - sqlc.narg('origin_lng')::float8) / 2), 2)
+        ))))::float8 AS distance_m
+        FROM restaurant_addresses a
+        WHERE a.restaurant_id = f.id
+    ) d ON true
+), page AS (
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY rating, id) AS position
+     FROM filtered
+     WHERE sqlc.arg('sort')::text = 'rating'
+       AND (sqlc.narg('cursor_id')::uuid IS NULL OR (rating, id) > ((sqlc.narg('cursor_number')::float8)::integer, sqlc.narg('cursor_id')))
+     ORDER BY rating, id
+     LIMIT sqlc.arg('limit')::integer + sqlc.arg('offset')::integer)
+    UNION ALL
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY rating DESC, id DESC) AS position
+     FROM filtered
+     WHERE sqlc.arg('sort')::text = '-rating'
+       AND (sqlc.narg('cursor_id')::uuid IS NULL OR (rating, id) < ((-sqlc.narg('cursor_number')::float8)::integer, sqlc.narg('cursor_id')))
+     ORDER BY rating DESC, id DESC
+     LIMIT sqlc.arg('limit')::integer + sqlc.arg('offset')::integer)
+    UNION ALL
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY total_reviews, id) AS position
+     FROM filtered
+     WHERE sqlc.arg('sort')::text = 'total_reviews'
+       AND (sqlc.narg('cursor_id')::uuid IS NULL OR (total_reviews, id) > ((sqlc.narg('cursor_number')::float8)::integer, sqlc.narg('cursor_id')))
+     ORDER BY total_reviews, id
+     LIMIT sqlc.arg('limit')::integer + sqlc.arg('offset')::integer)
+    UNION ALL
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY total_reviews DESC, id DESC) AS position
+     FROM filtered
+     WHERE sqlc.arg('sort')::text = '-total_reviews'
+       AND (sqlc.narg('cursor_id')::uuid IS NULL OR (total_reviews, id) < ((-sqlc.narg('cursor_number')::float8)::integer, sqlc.narg('cursor_id')))
+     ORDER BY total_reviews DESC, id DESC
+     LIMIT sqlc.arg('limit')::integer + sqlc.arg('offset')::integer)
+    UNION ALL
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY delivery_fee, id) AS position
+     FROM filtered
+     WHERE sqlc.arg('sort')::text = 'delivery_fee'
+       AND (sqlc.narg('cursor_id')::uuid IS NULL OR (delivery_fee, id) > ((sqlc.narg('cursor_number')::float8)::bigint, sqlc.narg('cursor_id')))
+     ORDER BY delivery_fee, id
+     LIMIT sqlc.arg('limit')::integer + sqlc.arg('offset')::integer)
+    UNION ALL
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY delivery_fee DESC, id DESC) AS position
+     FROM filtered
+     WHERE sqlc.arg('sort')::text = '-delivery_fee'
+       AND (sqlc.narg('cursor_id')::uuid IS NULL OR (delivery_fee, id) < ((-sqlc.narg('cursor_number')::float8)::bigint, sqlc.narg('cursor_id')))
+     ORDER BY delivery_fee DESC, id DESC
+     LIMIT sqlc.arg('limit')::integer + sqlc.arg('offset')::integer)
+    UNION ALL
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY min_order_value, id) AS position
+     FROM filtered
+     WHERE sqlc.arg('sort')::text = 'min_order_value'
+       AND (sqlc.narg('cursor_id')::uuid IS NULL OR (min_order_value, id) > ((sqlc.narg('cursor_number')::float8)::bigint, sqlc.narg('cursor_id')))
+     ORDER BY min_order_value, id
+     LIMIT sqlc.arg('limit')::integer + sqlc.arg('offset')::integer)
+    UNION ALL
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY min_order_value DESC, id DESC) AS position
+     FROM filtered
+     WHERE sqlc.arg('sort')::text = '-min_order_value'
+       AND (sqlc.narg('cursor_id')::uuid IS NULL OR (min_order_value, id) < ((-sqlc.narg('cursor_number')::float8)::bigint, sqlc.narg('cursor_id')))
+     ORDER BY min_order_value DESC, id DESC
+     LIMIT sqlc.arg('limit')::integer + sqlc.arg('offset')::integer)
+    UNION ALL
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY preparation_time_min, id) AS position
+     FROM filtered
+     WHERE sqlc.arg('sort')::text = 'preparation_time_min'
+       AND (sqlc.narg('cursor_id')::uuid IS NULL OR (preparation_time_min, id) > ((sqlc.narg('cursor_number')::float8)::integer, sqlc.narg('cursor_id')))
+     ORDER BY preparation_time_min, id
+     LIMIT sqlc.arg('limit')::integer + sqlc.arg('offset')::integer)
+    UNION ALL
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY preparation_time_min DESC, id DESC) AS position
+     FROM filtered
+     WHERE sqlc.arg('sort')::text = '-preparation_time_min'
+       AND (sqlc.narg('cursor_id')::uuid IS NULL OR (preparation_time_min, id) < ((-sqlc.narg('cursor_number')::float8)::integer, sqlc.narg('cursor_id')))
+     ORDER BY preparation_time_min DESC, id DESC
+     LIMIT sqlc.arg('limit')::integer + sqlc.arg('offset')::integer)
+    UNION ALL
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY name, id) AS position
+     FROM filtered
+     WHERE sqlc.arg('sort')::text = 'name'
+       AND (sqlc.narg('cursor_id')::uuid IS NULL OR (name, id) > (sqlc.narg('cursor_text')::text, sqlc.narg('cursor_id')))
+     ORDER BY name, id
+     LIMIT sqlc.arg('limit')::integer + sqlc.arg('offset')::integer)
+    UNION ALL
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY name DESC, id DESC) AS position
+     FROM filtered
+     WHERE sqlc.arg('sort')::text = '-name'
+       AND (sqlc.narg('cursor_id')::uuid IS NULL OR (name, id) < (sqlc.narg('cursor_text')::text, sqlc.narg('cursor_id')))
+     ORDER BY name DESC, id DESC
+     LIMIT sqlc.arg('limit')::integer + sqlc.arg('offset')::integer)
+    UNION ALL
+    (SELECT id, distance_m, row_number() OVER (ORDER BY near_key, id) AS position
+     FROM distances
+     WHERE sqlc.arg('sort')::text = 'distance'
+       AND (sqlc.narg('cursor_id')::uuid IS NULL OR (near_key, id) > (sqlc.narg('cursor_number')::float8, sqlc.narg('cursor_id')))
+     ORDER BY near_key, id
+     LIMIT sqlc.arg('limit')::integer + sqlc.arg('offset')::integer)
+    UNION ALL
+    (SELECT id, distance_m, row_number() OVER (ORDER BY far_key, id DESC) AS position
+     FROM distances
+     WHERE sqlc.arg('sort')::text = '-distance'
+       AND (sqlc.narg('cursor_id')::uuid IS NULL OR far_key > sqlc.narg('cursor_number')::float8
+            OR (far_key = sqlc.narg('cursor_number') AND id < sqlc.narg('cursor_id')))
+     ORDER BY far_key, id DESC
+     LIMIT sqlc.arg('limit')::integer + sqlc.arg('offset')::integer)
+)
+SELECT sqlc.embed(restaurants), page.distance_m
+FROM page
+JOIN restaurants ON restaurants.id = page.id
+ORDER BY page.position
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountRestaurants :one
+SELECT COUNT(*) FROM filtered_restaurants(
+    sqlc.narg('category')::text, sqlc.narg('status')::text,
+    sqlc.narg('supports_delivery')::boolean, sqlc.narg('supports_pickup')::boolean,
+    sqlc.narg('max_delivery_fee')::bigint, sqlc.narg('max_preparation_time')::integer,
+    sqlc.narg('city')::text, sqlc.narg('state')::text, sqlc.narg('has_delivery_area')::boolean,
+    sqlc.narg('query')::text, CASE WHEN sqlc.narg('open_now')::boolean THEN now() END
+) restaurants;
+
+-- name: CountRestaurantsByCategory :many
+-- Faceta de categorias da taxonomia (um restaurante conta em cada uma das suas categorias)
+SELECT cat.slug AS value, cat.name AS label, COUNT(*) AS count
+FROM filtered_restaurants(
+    sqlc.narg('category')::text, sqlc.narg('status')::text,
+    sqlc.narg('supports_delivery')::boolean, sqlc.narg('supports_pickup')::boolean,
+    sqlc.narg('max_delivery_fee')::bigint, sqlc.narg('max_preparation_time')::integer,
+    sqlc.narg('city')::text, sqlc.narg('state')::text, sqlc.narg('has_delivery_area')::boolean,
+    sqlc.narg('query')::text, CASE WHEN sqlc.narg('open_now')::boolean THEN now() END
+) restaurants
+JOIN restaurant_categories rcat ON rcat.restaurant_id = restaurants.id
+JOIN categories cat ON cat.id = rcat.category_id
+GROUP BY cat.id
+ORDER BY count DESC, value;
+
+-- name: CountRestaurantsByCity :many
+-- Faceta de cidades: mesmos predicados da listagem, agrupando sem diferenciar maiúsculas
+SELECT min(addr.city)::text AS value, COUNT(*) AS count
+FROM filtered_restaurants(
+    sqlc.narg('category')::text, sqlc.narg('status')::text,
+    sqlc.narg('supports_delivery')::boolean, sqlc.narg('supports_pickup')::boolean,
+    sqlc.narg('max_delivery_fee')::bigint, sqlc.narg('max_preparation_time')::integer,
+    sqlc.narg('city')::text, sqlc.narg('state')::text, sqlc.narg('has_delivery_area')::boolean,
+    sqlc.narg('query')::text, CASE WHEN sqlc.narg('open_now')::boolean THEN now() END
+) restaurants
+JOIN restaurant_addresses addr ON addr.restaurant_id = restaurants.id
+GROUP BY lower(addr.city)
+ORDER BY count DESC, value;
+
+-- name: CountRestaurantsByPaymentMethod :many
+-- Faceta de métodos de pagamento: mesmos predicados da listagem
+SELECT pm.method AS value, COUNT(*) AS count
+FROM filtered_restaurants(
+    sqlc.narg('category')::text, sqlc.narg('status')::text,
+    sqlc.narg('supports_delivery')::boolean, sqlc.narg('supports_pickup')::boolean,
+    sqlc.narg('max_delivery_fee')::bigint, sqlc.narg('max_preparation_time')::integer,
+    sqlc.narg('city')::text, sqlc.narg('state')::text, sqlc.narg('has_delivery_area')::boolean,
+    sqlc.narg('query')::text, CASE WHEN sqlc.narg('open_now')::boolean THEN now() END
+) restaurants
+JOIN restaurant_payment_methods pm ON pm.restaurant_id = restaurants.id
+GROUP BY pm.method
+ORDER BY count DESC, value;
+
+-- name: SearchRestaurants :many
+-- Busca textual ordenada por relevância; o cursor inclui o rank para manter o keyset estável
+SELECT sqlc.embed(restaurants),
+       ts_rank(search_vector, websearch_to_tsquery('portuguese_unaccent', sqlc.arg('query')::text)) AS search_rank
+FROM filtered_restaurants(
+    sqlc.narg('category')::text, sqlc.narg('status')::text,
+    sqlc.narg('supports_delivery')::boolean, sqlc.narg('supports_pickup')::boolean,
+    sqlc.narg('max_delivery_fee')::bigint, sqlc.narg('max_preparation_time')::integer,
+    sqlc.narg('city')::text, sqlc.narg('state')::text, sqlc.narg('has_delivery_area')::boolean,
+    sqlc.arg('query')::text, CASE WHEN sqlc.narg('open_now')::boolean THEN now() END
+) restaurants
+WHERE (sqlc.narg('cursor_created_at')::timestamp IS NULL
+       OR (ts_rank(search_vector, websearch_to_tsquery('portuguese_unaccent', sqlc.arg('query')::text)), created_at, id)
+          < (sqlc.narg('cursor_rank')::real, sqlc.narg('cursor_created_at'), sqlc.narg('cursor_id')::uuid))
+ORDER BY search_rank DESC, created_at DESC, id DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
--- name: UpdateRestaurantStatus :one
+-- name: ListNearbyRestaurants :many
+-- Bounding box (indexado) como pré-filtro e haversine para a distância exata
+SELECT sqlc.embed(restaurants), nearby.distance_m
+FROM (
+    SELECT a.restaurant_id,
+           (2 * 6371000 * asin(least(1, sqrt(
+               power(sin(radians(a.lat - sqlc.arg('lat')::float8) / 2), 2) +
+               cos(radians(sqlc.arg('lat')::float8)) * cos(radians(a.lat)) *
+               power(sin(radians(a.lng - sqlc.arg('lng')::float8) / 2), 2)
+           ))))::float8 AS distance_m
+    FROM restaurant_addresses a
+    WHERE a.lat BETWEEN sqlc.arg('min_lat')::float8 AND sqlc.arg('max_lat')::float8
+      AND a.lng BETWEEN sqlc.arg('min_lng')::float8 AND sqlc.arg('max_lng')::float8
+) nearby
+JOIN restaurants ON restaurants.id = nearby.restaurant_id
+WHERE nearby.distance_m <= sqlc.arg('radius_m')::float8
+  -- Aberto agora: mesma regra da listagem, avaliada no fuso de cada restaurante
+  AND (NOT sqlc.arg('open_now')::boolean
+       OR (restaurants.status = 'OPEN' AND restaurant_is_open(restaurants.id, restaurants.timezone, now())))
+ORDER BY nearby.distance_m, restaurants.id
+LIMIT sqlc.arg('limit');
+
+-- name: UpdateRestaurantStatus :execrows
+-- Só transiciona se o status ainda for o lido (from_status); 0 linhas = alterado por outra requisição
+UPDATE restaurants
+SET status = $2, status_reason = $3, version = version + 1, updated_at = NOW()
+WHERE id = $1 AND status = sqlc.arg(from_status);
+
+-- name: UpdateRestaurantProfile :one
 UPDATE restaurants
-SET status = $2, updated_at = NOW()
-WHERE id = $1
+SET name = $2, slug = $3, description = $4, category = $5, delivery_fee = $6, min_order_value = $7,
+    preparation_time_min = $8, supports_pickup = $9, supports_delivery = $10,
+    logo_url = $11, banner_url = $12, timezone = $13, version = version + 1, updated_at = NOW()
+WHERE id = $1 AND version = $14
 RETURNING *;
 
 -- name: CreateRestaurantAddress :one
@@ -40,6 +285,10 @@ RETURNING *;
 -- name: GetRestaurantAddress :one
 SELECT * FROM restaurant_addresses WHERE restaurant_id = $1 LIMIT 1;
 
+-- name: GetRestaurantAddressesByRestaurants :many
+SELECT * FROM restaurant_addresses
+WHERE restaurant_id = ANY(sqlc.arg('restaurant_ids')::uuid[]);
+
 -- name: CreateOpeningHour :one
 INSERT INTO restaurant_opening_hours (
     restaurant_id, weekday, opens_at, closes_at
@@ -55,6 +304,11 @@ SELECT * FROM restaurant_opening_hours
 WHERE restaurant_id = $1
 ORDER BY weekday, opens_at;
 
+-- name: GetOpeningHoursByRestaurants :many
+SELECT * FROM restaurant_opening_hours
+WHERE restaurant_id = ANY(sqlc.arg('restaurant_ids')::uuid[])
+ORDER BY restaurant_id, weekday, opens_at;
+
 -- name: CreatePaymentMethod :one
 INSERT INTO restaurant_payment_methods (
     restaurant_id, method
@@ -70,3 +324,7 @@ SELECT * FROM restaurant_payment_methods
 WHERE restaurant_id = $1
 ORDER BY method;
 
+-- name: GetPaymentMethodsByRestaurants :many
+SELECT * FROM restaurant_payment_methods
+WHERE restaurant_id = ANY(sqlc.arg('restaurant_ids')::uuid[])
+ORDER BY restaurant_id, method;
@@ -9,6 +9,78 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type Category struct {
+	ID        uuid.UUID        `json:"id"`
+	Slug      string           `json:"slug"`
+	Name      string           `json:"name"`
+	Icon      pgtype.Text      `json:"icon"`
+	Position  int32            `json:"position"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+}
+
+type MenuAvailabilityWindow struct {
+	ID           uuid.UUID        `json:"id"`
+	RestaurantID uuid.UUID        `json:"restaurant_id"`
+	SectionID    pgtype.UUID      `json:"section_id"`
+	ItemID       pgtype.UUID      `json:"item_id"`
+	Weekday      int32            `json:"weekday"`
+	OpensAt      int32            `json:"opens_at"`
+	ClosesAt     int32            `json:"closes_at"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+}
+
+type MenuItem struct {
+	ID           uuid.UUID        `json:"id"`
+	RestaurantID uuid.UUID        `json:"restaurant_id"`
+	SectionID    uuid.UUID        `json:"section_id"`
+	Name         string           `json:"name"`
+	Description  pgtype.Text      `json:"description"`
+	Price        int64            `json:"price"`
+	ImageUrl     pgtype.Text      `json:"image_url"`
+	Position     int32            `json:"position"`
+	Active       bool             `json:"active"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+}
+
+type MenuOption struct {
+	ID           uuid.UUID        `json:"id"`
+	RestaurantID uuid.UUID        `json:"restaurant_id"`
+	GroupID      uuid.UUID        `json:"group_id"`
+	Name         string           `json:"name"`
+	PriceDelta   int64            `json:"price_delta"`
+	Position     int32            `json:"position"`
+	Active       bool             `json:"active"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+}
+
+type MenuOptionGroup struct {
+	ID            uuid.UUID        `json:"id"`
+	RestaurantID  uuid.UUID        `json:"restaurant_id"`
+	ItemID        uuid.UUID        `json:"item_id"`
+	Name          string           `json:"name"`
+	MinSelections int32            `json:"min_selections"`
+	MaxSelections int32            `json:"max_selections"`
+	Required      bool             `json:"required"`
+	Position      int32            `json:"position"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+}
+
+type MenuSection struct {
+	ID           uuid.UUID        `json:"id"`
+	RestaurantID uuid.UUID        `json:"restaurant_id"`
+	Name         string           `json:"name"`
+	Description  pgtype.Text      `json:"description"`
+	Position     int32            `json:"position"`
+	Active       bool             `json:"active"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+}
+
 type Restaurant struct {
 	ID                 uuid.UUID        `json:"id"`
 	Name               string           `json:"name"`
@@ -27,6 +99,10 @@ type Restaurant struct {
 	BannerUrl          pgtype.Text      `json:"banner_url"`
 	CreatedAt          pgtype.Timestamp `json:"created_at"`
 	UpdatedAt          pgtype.Timestamp `json:"updated_at"`
+	Version            int32            `json:"version"`
+	StatusReason       pgtype.Text      `json:"status_reason"`
+	Timezone           pgtype.Text      `json:"timezone"`
+	SearchVector       interface{}      `json:"search_vector"`
 }
 
 type RestaurantAddress struct {
@@ -38,8 +114,25 @@ type RestaurantAddress struct {
 	City         string           `json:"city"`
 	State        string           `json:"state"`
 	ZipCode      string           `json:"zip_code"`
-	Lat          float64          `json:"lat"`
-	Lng          float64          `json:"lng"`
+	Lat          pgtype.Float8    `json:"lat"`
+	Lng          pgtype.Float8    `json:"lng"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+}
+
+type RestaurantCategory struct {
+	RestaurantID uuid.UUID        `json:"restaurant_id"`
+	CategoryID   uuid.UUID        `json:"category_id"`
+	Position     int32            `json:"position"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+}
+
+type RestaurantDeliveryArea struct {
+	ID           uuid.UUID        `json:"id"`
+	RestaurantID uuid.UUID        `json:"restaurant_id"`
+	Kind         string           `json:"kind"`
+	RadiusM      pgtype.Int4      `json:"radius_m"`
+	Polygon      []byte           `json:"polygon"`
 	CreatedAt    pgtype.Timestamp `json:"created_at"`
 	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
 }
@@ -61,3 +154,32 @@ type RestaurantPaymentMethod struct {
 	CreatedAt    pgtype.Timestamp `json:"created_at"`
 	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
 }
+
+type RestaurantSlugHistory struct {
+	Slug         string           `json:"slug"`
+	RestaurantID uuid.UUID        `json:"restaurant_id"`
+	RetiredAt    pgtype.Timestamp `json:"retired_at"`
+}
+
+type RestaurantSpecialHour struct {
+	ID           uuid.UUID        `json:"id"`
+	RestaurantID uuid.UUID        `json:"restaurant_id"`
+	Date         pgtype.Date      `json:"date"`
+	OpensAt      pgtype.Int4      `json:"opens_at"`
+	ClosesAt     pgtype.Int4      `json:"closes_at"`
+	Note         pgtype.Text      `json:"note"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+}
+
+type RestaurantStatusHistory struct {
+	ID           uuid.UUID        `json:"id"`
+	RestaurantID uuid.UUID        `json:"restaurant_id"`
+	FromStatus   string           `json:"from_status"`
+	ToStatus     string           `json:"to_status"`
+	Event        string           `json:"event"`
+	Reason       pgtype.Text      `json:"reason"`
+	ActorID      pgtype.Text      `json:"actor_id"`
+	ActorRole    string           `json:"actor_role"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+}
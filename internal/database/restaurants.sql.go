@@ -12,6 +12,249 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countRestaurants = `-- name: CountRestaurants :one
+SELECT COUNT(*) FROM filtered_restaurants(
+    $1::text, $2::text,
+    $3::boolean, $4::boolean,
+    $5::bigint, $6::integer,
+    $7::text, $8::text, $9::boolean,
+    $10::text, CASE WHEN $11::boolean THEN now() END
+) restaurants
+`
+
+type CountRestaurantsParams struct {
+	Category           pgtype.Text `json:"category"`
+	Status             pgtype.Text `json:"status"`
+	SupportsDelivery   pgtype.Bool `json:"supports_delivery"`
+	SupportsPickup     pgtype.Bool `json:"supports_pickup"`
+	MaxDeliveryFee     pgtype.Int8 `json:"max_delivery_fee"`
+	MaxPreparationTime pgtype.Int4 `json:"max_preparation_time"`
+	City               pgtype.Text `json:"city"`
+	State              pgtype.Text `json:"state"`
+	HasDeliveryArea    pgtype.Bool `json:"has_delivery_area"`
+	Query              pgtype.Text `json:"query"`
+	OpenNow            pgtype.Bool `json:"open_now"`
+}
+
+func (q *Queries) CountRestaurants(ctx context.Context, arg CountRestaurantsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countRestaurants,
+		arg.Category,
+		arg.Status,
+		arg.SupportsDelivery,
+		arg.SupportsPickup,
+		arg.MaxDeliveryFee,
+		arg.MaxPreparationTime,
+		arg.City,
+		arg.State,
+		arg.HasDeliveryArea,
+		arg.Query,
+		arg.OpenNow,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countRestaurantsByCategory = `-- name: CountRestaurantsByCategory :many
+SELECT cat.slug AS value, cat.name AS label, COUNT(*) AS count
+FROM filtered_restaurants(
+    $1::text, $2::text,
+    $3::boolean, $4::boolean,
+    $5::bigint, $6::integer,
+    $7::text, $8::text, $9::boolean,
+    $10::text, CASE WHEN $11::boolean THEN now() END
+) restaurants
+JOIN restaurant_categories rcat ON rcat.restaurant_id = restaurants.id
+JOIN categories cat ON cat.id = rcat.category_id
+GROUP BY cat.id
+ORDER BY count DESC, value
+`
+
+type CountRestaurantsByCategoryParams struct {
+	Category           pgtype.Text `json:"category"`
+	Status             pgtype.Text `json:"status"`
+	SupportsDelivery   pgtype.Bool `json:"supports_delivery"`
+	SupportsPickup     pgtype.Bool `json:"supports_pickup"`
+	MaxDeliveryFee     pgtype.Int8 `json:"max_delivery_fee"`
+	MaxPreparationTime pgtype.Int4 `json:"max_preparation_time"`
+	City               pgtype.Text `json:"city"`
+	State              pgtype.Text `json:"state"`
+	HasDeliveryArea    pgtype.Bool `json:"has_delivery_area"`
+	Query              pgtype.Text `json:"query"`
+	OpenNow            pgtype.Bool `json:"open_now"`
+}
+
+type CountRestaurantsByCategoryRow struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+	Count int64  `json:"count"`
+}
+
+// Faceta de categorias da taxonomia (um restaurante conta em cada uma das suas categorias)
+func (q *Queries) CountRestaurantsByCategory(ctx context.Context, arg CountRestaurantsByCategoryParams) ([]CountRestaurantsByCategoryRow, error) {
+	rows, err := q.db.Query(ctx, countRestaurantsByCategory,
+		arg.Category,
+		arg.Status,
+		arg.SupportsDelivery,
+		arg.SupportsPickup,
+		arg.MaxDeliveryFee,
+		arg.MaxPreparationTime,
+		arg.City,
+		arg.State,
+		arg.HasDeliveryArea,
+		arg.Query,
+		arg.OpenNow,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountRestaurantsByCategoryRow
+	for rows.Next() {
+		var i CountRestaurantsByCategoryRow
+		if err := rows.Scan(&i.Value, &i.Label, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countRestaurantsByCity = `-- name: CountRestaurantsByCity :many
+SELECT min(addr.city)::text AS value, COUNT(*) AS count
+FROM filtered_restaurants(
+    $1::text, $2::text,
+    $3::boolean, $4::boolean,
+    $5::bigint, $6::integer,
+    $7::text, $8::text, $9::boolean,
+    $10::text, CASE WHEN $11::boolean THEN now() END
+) restaurants
+JOIN restaurant_addresses addr ON addr.restaurant_id = restaurants.id
+GROUP BY lower(addr.city)
+ORDER BY count DESC, value
+`
+
+type CountRestaurantsByCityParams struct {
+	Category           pgtype.Text `json:"category"`
+	Status             pgtype.Text `json:"status"`
+	SupportsDelivery   pgtype.Bool `json:"supports_delivery"`
+	SupportsPickup     pgtype.Bool `json:"supports_pickup"`
+	MaxDeliveryFee     pgtype.Int8 `json:"max_delivery_fee"`
+	MaxPreparationTime pgtype.Int4 `json:"max_preparation_time"`
+	City               pgtype.Text `json:"city"`
+	State              pgtype.Text `json:"state"`
+	HasDeliveryArea    pgtype.Bool `json:"has_delivery_area"`
+	Query              pgtype.Text `json:"query"`
+	OpenNow            pgtype.Bool `json:"open_now"`
+}
+
+type CountRestaurantsByCityRow struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// Faceta de cidades: mesmos predicados da listagem, agrupando sem diferenciar maiúsculas
+func (q *Queries) CountRestaurantsByCity(ctx context.Context, arg CountRestaurantsByCityParams) ([]CountRestaurantsByCityRow, error) {
+	rows, err := q.db.Query(ctx, countRestaurantsByCity,
+		arg.Category,
+		arg.Status,
+		arg.SupportsDelivery,
+		arg.SupportsPickup,
+		arg.MaxDeliveryFee,
+		arg.MaxPreparationTime,
+		arg.City,
+		arg.State,
+		arg.HasDeliveryArea,
+		arg.Query,
+		arg.OpenNow,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountRestaurantsByCityRow
+	for rows.Next() {
+		var i CountRestaurantsByCityRow
+		if err := rows.Scan(&i.Value, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countRestaurantsByPaymentMethod = `-- name: CountRestaurantsByPaymentMethod :many
+SELECT pm.method AS value, COUNT(*) AS count
+FROM filtered_restaurants(
+    $1::text, $2::text,
+    $3::boolean, $4::boolean,
+    $5::bigint, $6::integer,
+    $7::text, $8::text, $9::boolean,
+    $10::text, CASE WHEN $11::boolean THEN now() END
+) restaurants
+JOIN restaurant_payment_methods pm ON pm.restaurant_id = restaurants.id
+GROUP BY pm.method
+ORDER BY count DESC, value
+`
+
+type CountRestaurantsByPaymentMethodParams struct {
+	Category           pgtype.Text `json:"category"`
+	Status             pgtype.Text `json:"status"`
+	SupportsDelivery   pgtype.Bool `json:"supports_delivery"`
+	SupportsPickup     pgtype.Bool `json:"supports_pickup"`
+	MaxDeliveryFee     pgtype.Int8 `json:"max_delivery_fee"`
+	MaxPreparationTime pgtype.Int4 `json:"max_preparation_time"`
+	City               pgtype.Text `json:"city"`
+	State              pgtype.Text `json:"state"`
+	HasDeliveryArea    pgtype.Bool `json:"has_delivery_area"`
+	Query              pgtype.Text `json:"query"`
+	OpenNow            pgtype.Bool `json:"open_now"`
+}
+
+type CountRestaurantsByPaymentMethodRow struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// Faceta de métodos de pagamento: mesmos predicados da listagem
+func (q *Queries) CountRestaurantsByPaymentMethod(ctx context.Context, arg CountRestaurantsByPaymentMethodParams) ([]CountRestaurantsByPaymentMethodRow, error) {
+	rows, err := q.db.Query(ctx, countRestaurantsByPaymentMethod,
+		arg.Category,
+		arg.Status,
+		arg.SupportsDelivery,
+		arg.SupportsPickup,
+		arg.MaxDeliveryFee,
+		arg.MaxPreparationTime,
+		arg.City,
+		arg.State,
+		arg.HasDeliveryArea,
+		arg.Query,
+		arg.OpenNow,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountRestaurantsByPaymentMethodRow
+	for rows.Next() {
+		var i CountRestaurantsByPaymentMethodRow
+		if err := rows.Scan(&i.Value, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createOpeningHour = `-- name: CreateOpeningHour :one
 INSERT INTO restaurant_opening_hours (
     restaurant_id, weekday, opens_at, closes_at
@@ -77,10 +320,10 @@ const createRestaurant = `-- name: CreateRestaurant :one
 INSERT INTO restaurants (
     name, slug, description, status, category, rating, total_reviews,
     delivery_fee, min_order_value, preparation_time_min,
-    supports_pickup, supports_delivery, logo_url, banner_url
+    supports_pickup, supports_delivery, logo_url, banner_url, timezone
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
-) RETURNING id, name, slug, description, status, category, rating, total_reviews, delivery_fee, min_order_value, preparation_time_min, supports_pickup, supports_delivery, logo_url, banner_url, created_at, updated_at
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
+) RETURNING id, name, slug, description, status, category, rating, total_reviews, delivery_fee, min_order_value, preparation_time_min, supports_pickup, supports_delivery, logo_url, banner_url, created_at, updated_at, version, status_reason, timezone, search_vector
 `
 
 type CreateRestaurantParams struct {
@@ -98,6 +341,7 @@ type CreateRestaurantParams struct {
 	SupportsDelivery   bool        `json:"supports_delivery"`
 	LogoUrl            pgtype.Text `json:"logo_url"`
 	BannerUrl          pgtype.Text `json:"banner_url"`
+	Timezone           pgtype.Text `json:"timezone"`
 }
 
 func (q *Queries) CreateRestaurant(ctx context.Context, arg CreateRestaurantParams) (Restaurant, error) {
@@ -116,6 +360,7 @@ func (q *Queries) CreateRestaurant(ctx context.Context, arg CreateRestaurantPara
 		arg.SupportsDelivery,
 		arg.LogoUrl,
 		arg.BannerUrl,
+		arg.Timezone,
 	)
 	var i Restaurant
 	err := row.Scan(
@@ -136,6 +381,10 @@ func (q *Queries) CreateRestaurant(ctx context.Context, arg CreateRestaurantPara
 		&i.BannerUrl,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.StatusReason,
+		&i.Timezone,
+		&i.SearchVector,
 	)
 	return i, err
 }
@@ -149,15 +398,15 @@ INSERT INTO restaurant_addresses (
 `
 
 type CreateRestaurantAddressParams struct {
-	RestaurantID uuid.UUID   `json:"restaurant_id"`
-	Street       string      `json:"street"`
-	Number       string      `json:"number"`
-	Complement   pgtype.Text `json:"complement"`
-	City         string      `json:"city"`
-	State        string      `json:"state"`
-	ZipCode      string      `json:"zip_code"`
-	Lat          float64     `json:"lat"`
-	Lng          float64     `json:"lng"`
+	RestaurantID uuid.UUID     `json:"restaurant_id"`
+	Street       string        `json:"street"`
+	Number       string        `json:"number"`
+	Complement   pgtype.Text   `json:"complement"`
+	City         string        `json:"city"`
+	State        string        `json:"state"`
+	ZipCode      string        `json:"zip_code"`
+	Lat          pgtype.Float8 `json:"lat"`
+	Lng          pgtype.Float8 `json:"lng"`
 }
 
 func (q *Queries) CreateRestaurantAddress(ctx context.Context, arg CreateRestaurantAddressParams) (RestaurantAddress, error) {
@@ -242,6 +491,40 @@ func (q *Queries) GetOpeningHoursByRestaurant(ctx context.Context, restaurantID
 	return items, nil
 }
 
+const getOpeningHoursByRestaurants = `-- name: GetOpeningHoursByRestaurants :many
+SELECT id, restaurant_id, weekday, opens_at, closes_at, created_at, updated_at FROM restaurant_opening_hours
+WHERE restaurant_id = ANY($1::uuid[])
+ORDER BY restaurant_id, weekday, opens_at
+`
+
+func (q *Queries) GetOpeningHoursByRestaurants(ctx context.Context, restaurantIds []uuid.UUID) ([]RestaurantOpeningHour, error) {
+	rows, err := q.db.Query(ctx, getOpeningHoursByRestaurants, restaurantIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RestaurantOpeningHour
+	for rows.Next() {
+		var i RestaurantOpeningHour
+		if err := rows.Scan(
+			&i.ID,
+			&i.RestaurantID,
+			&i.Weekday,
+			&i.OpensAt,
+			&i.ClosesAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPaymentMethodsByRestaurant = `-- name: GetPaymentMethodsByRestaurant :many
 SELECT id, restaurant_id, method, created_at, updated_at FROM restaurant_payment_methods
 WHERE restaurant_id = $1
@@ -274,6 +557,38 @@ func (q *Queries) GetPaymentMethodsByRestaurant(ctx context.Context, restaurantI
 	return items, nil
 }
 
+const getPaymentMethodsByRestaurants = `-- name: GetPaymentMethodsByRestaurants :many
+SELECT id, restaurant_id, method, created_at, updated_at FROM restaurant_payment_methods
+WHERE restaurant_id = ANY($1::uuid[])
+ORDER BY restaurant_id, method
+`
+
+func (q *Queries) GetPaymentMethodsByRestaurants(ctx context.Context, restaurantIds []uuid.UUID) ([]RestaurantPaymentMethod, error) {
+	rows, err := q.db.Query(ctx, getPaymentMethodsByRestaurants, restaurantIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RestaurantPaymentMethod
+	for rows.Next() {
+		var i RestaurantPaymentMethod
+		if err := rows.Scan(
+			&i.ID,
+			&i.RestaurantID,
+			&i.Method,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getRestaurantAddress = `-- name: GetRestaurantAddress :one
 SELECT id, restaurant_id, street, number, complement, city, state, zip_code, lat, lng, created_at, updated_at FROM restaurant_addresses WHERE restaurant_id = $1 LIMIT 1
 `
@@ -298,8 +613,46 @@ func (q *Queries) GetRestaurantAddress(ctx context.Context, restaurantID uuid.UU
 	return i, err
 }
 
+const getRestaurantAddressesByRestaurants = `-- name: GetRestaurantAddressesByRestaurants :many
+SELECT id, restaurant_id, street, number, complement, city, state, zip_code, lat, lng, created_at, updated_at FROM restaurant_addresses
+WHERE restaurant_id = ANY($1::uuid[])
+`
+
+func (q *Queries) GetRestaurantAddressesByRestaurants(ctx context.Context, restaurantIds []uuid.UUID) ([]RestaurantAddress, error) {
+	rows, err := q.db.Query(ctx, getRestaurantAddressesByRestaurants, restaurantIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RestaurantAddress
+	for rows.Next() {
+		var i RestaurantAddress
+		if err := rows.Scan(
+			&i.ID,
+			&i.RestaurantID,
+			&i.Street,
+			&i.Number,
+			&i.Complement,
+			&i.City,
+			&i.State,
+			&i.ZipCode,
+			&i.Lat,
+			&i.Lng,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getRestaurantByID = `-- name: GetRestaurantByID :one
-SELECT id, name, slug, description, status, category, rating, total_reviews, delivery_fee, min_order_value, preparation_time_min, supports_pickup, supports_delivery, logo_url, banner_url, created_at, updated_at FROM restaurants WHERE id = $1 LIMIT 1
+SELECT id, name, slug, description, status, category, rating, total_reviews, delivery_fee, min_order_value, preparation_time_min, supports_pickup, supports_delivery, logo_url, banner_url, created_at, updated_at, version, status_reason, timezone, search_vector FROM restaurants WHERE id = $1 LIMIT 1
 `
 
 func (q *Queries) GetRestaurantByID(ctx context.Context, id uuid.UUID) (Restaurant, error) {
@@ -323,12 +676,50 @@ func (q *Queries) GetRestaurantByID(ctx context.Context, id uuid.UUID) (Restaura
 		&i.BannerUrl,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.StatusReason,
+		&i.Timezone,
+		&i.SearchVector,
+	)
+	return i, err
+}
+
+const getRestaurantByIDForUpdate = `-- name: GetRestaurantByIDForUpdate :one
+SELECT id, name, slug, description, status, category, rating, total_reviews, delivery_fee, min_order_value, preparation_time_min, supports_pickup, supports_delivery, logo_url, banner_url, created_at, updated_at, version, status_reason, timezone, search_vector FROM restaurants WHERE id = $1 LIMIT 1 FOR UPDATE
+`
+
+// Trava a linha até o fim da transação; usado nas transições de status
+func (q *Queries) GetRestaurantByIDForUpdate(ctx context.Context, id uuid.UUID) (Restaurant, error) {
+	row := q.db.QueryRow(ctx, getRestaurantByIDForUpdate, id)
+	var i Restaurant
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Slug,
+		&i.Description,
+		&i.Status,
+		&i.Category,
+		&i.Rating,
+		&i.TotalReviews,
+		&i.DeliveryFee,
+		&i.MinOrderValue,
+		&i.PreparationTimeMin,
+		&i.SupportsPickup,
+		&i.SupportsDelivery,
+		&i.LogoUrl,
+		&i.BannerUrl,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.StatusReason,
+		&i.Timezone,
+		&i.SearchVector,
 	)
 	return i, err
 }
 
 const getRestaurantBySlug = `-- name: GetRestaurantBySlug :one
-SELECT id, name, slug, description, status, category, rating, total_reviews, delivery_fee, min_order_value, preparation_time_min, supports_pickup, supports_delivery, logo_url, banner_url, created_at, updated_at FROM restaurants WHERE slug = $1 LIMIT 1
+SELECT id, name, slug, description, status, category, rating, total_reviews, delivery_fee, min_order_value, preparation_time_min, supports_pickup, supports_delivery, logo_url, banner_url, created_at, updated_at, version, status_reason, timezone, search_vector FROM restaurants WHERE slug = $1 LIMIT 1
 `
 
 func (q *Queries) GetRestaurantBySlug(ctx context.Context, slug string) (Restaurant, error) {
@@ -352,23 +743,155 @@ func (q *Queries) GetRestaurantBySlug(ctx context.Context, slug string) (Restaur
 		&i.BannerUrl,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.StatusReason,
+		&i.Timezone,
+		&i.SearchVector,
 	)
 	return i, err
 }
 
+const listNearbyRestaurants = `-- name: ListNearbyRestaurants :many
+SELECT restaurants.id, restaurants.name, restaurants.slug, restaurants.description, restaurants.status, restaurants.category, restaurants.rating, restaurants.total_reviews, restaurants.delivery_fee, restaurants.min_order_value, restaurants.preparation_time_min, restaurants.supports_pickup, restaurants.supports_delivery, restaurants.logo_url, restaurants.banner_url, restaurants.created_at, restaurants.updated_at, restaurants.version, restaurants.status_reason, restaurants.timezone, restaurants.search_vector, nearby.distance_m
+FROM (
+    SELECT a.restaurant_id,
+           (2 * 6371000 * asin(least(1, sqrt(
+               power(sin(radians(a.lat - $1::float8) / 2), 2) +
+               cos(radians($1::float8)) * cos(radians(a.lat)) *
+               power(sin(radians(a.lng - $2::float8) / 2), 2)
+           ))))::float8 AS distance_m
+    FROM restaurant_addresses a
+    WHERE a.lat BETWEEN $3::float8 AND $4::float8
+      AND a.lng BETWEEN $5::float8 AND $6::float8
+) nearby
+JOIN restaurants ON restaurants.id = nearby.restaurant_id
+WHERE nearby.distance_m <= $7::float8
+  -- Aberto agora: mesma regra da listagem, avaliada no fuso de cada restaurante
+  AND (NOT $8::boolean
+       OR (restaurants.status = 'OPEN' AND restaurant_is_open(restaurants.id, restaurants.timezone, now())))
+ORDER BY nearby.distance_m, restaurants.id
+LIMIT $9
+`
+
+type ListNearbyRestaurantsParams struct {
+	Lat     float64 `json:"lat"`
+	Lng     float64 `json:"lng"`
+	MinLat  float64 `json:"min_lat"`
+	MaxLat  float64 `json:"max_lat"`
+	MinLng  float64 `json:"min_lng"`
+	MaxLng  float64 `json:"max_lng"`
+	RadiusM float64 `json:"radius_m"`
+	OpenNow bool    `json:"open_now"`
+	Limit   int32   `json:"limit"`
+}
+
+type ListNearbyRestaurantsRow struct {
+	Restaurant Restaurant `json:"restaurant"`
+	DistanceM  float64    `json:"distance_m"`
+}
+
+// Bounding box (indexado) como pré-filtro e haversine para a distância exata
+func (q *Queries) ListNearbyRestaurants(ctx context.Context, arg ListNearbyRestaurantsParams) ([]ListNearbyRestaurantsRow, error) {
+	rows, err := q.db.Query(ctx, listNearbyRestaurants,
+		arg.Lat,
+		arg.Lng,
+		arg.MinLat,
+		arg.MaxLat,
+		arg.MinLng,
+		arg.MaxLng,
+		arg.RadiusM,
+		arg.OpenNow,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListNearbyRestaurantsRow
+	for rows.Next() {
+		var i ListNearbyRestaurantsRow
+		if err := rows.Scan(
+			&i.Restaurant.ID,
+			&i.Restaurant.Name,
+			&i.Restaurant.Slug,
+			&i.Restaurant.Description,
+			&i.Restaurant.Status,
+			&i.Restaurant.Category,
+			&i.Restaurant.Rating,
+			&i.Restaurant.TotalReviews,
+			&i.Restaurant.DeliveryFee,
+			&i.Restaurant.MinOrderValue,
+			&i.Restaurant.PreparationTimeMin,
+			&i.Restaurant.SupportsPickup,
+			&i.Restaurant.SupportsDelivery,
+			&i.Restaurant.LogoUrl,
+			&i.Restaurant.BannerUrl,
+			&i.Restaurant.CreatedAt,
+			&i.Restaurant.UpdatedAt,
+			&i.Restaurant.Version,
+			&i.Restaurant.StatusReason,
+			&i.Restaurant.Timezone,
+			&i.Restaurant.SearchVector,
+			&i.DistanceM,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listRestaurants = `-- name: ListRestaurants :many
-SELECT id, name, slug, description, status, category, rating, total_reviews, delivery_fee, min_order_value, preparation_time_min, supports_pickup, supports_delivery, logo_url, banner_url, created_at, updated_at FROM restaurants
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2
+SELECT id, name, slug, description, status, category, rating, total_reviews, delivery_fee, min_order_value, preparation_time_min, supports_pickup, supports_delivery, logo_url, banner_url, created_at, updated_at, version, status_reason, timezone, search_vector FROM filtered_restaurants(
+    $1::text, $2::text,
+    $3::boolean, $4::boolean,
+    $5::bigint, $6::integer,
+    $7::text, $8::text, $9::boolean,
+    NULL, CASE WHEN $10::boolean THEN now() END
+) restaurants
+WHERE ($11::timestamp IS NULL
+       OR (created_at, id) < ($11, $12::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $13 OFFSET $14
 `
 
 type ListRestaurantsParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+	Category           pgtype.Text      `json:"category"`
+	Status             pgtype.Text      `json:"status"`
+	SupportsDelivery   pgtype.Bool      `json:"supports_delivery"`
+	SupportsPickup     pgtype.Bool      `json:"supports_pickup"`
+	MaxDeliveryFee     pgtype.Int8      `json:"max_delivery_fee"`
+	MaxPreparationTime pgtype.Int4      `json:"max_preparation_time"`
+	City               pgtype.Text      `json:"city"`
+	State              pgtype.Text      `json:"state"`
+	HasDeliveryArea    pgtype.Bool      `json:"has_delivery_area"`
+	OpenNow            pgtype.Bool      `json:"open_now"`
+	CursorCreatedAt    pgtype.Timestamp `json:"cursor_created_at"`
+	CursorID           pgtype.UUID      `json:"cursor_id"`
+	Limit              int32            `json:"limit"`
+	Offset             int32            `json:"offset"`
 }
 
 func (q *Queries) ListRestaurants(ctx context.Context, arg ListRestaurantsParams) ([]Restaurant, error) {
-	rows, err := q.db.Query(ctx, listRestaurants, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listRestaurants,
+		arg.Category,
+		arg.Status,
+		arg.SupportsDelivery,
+		arg.SupportsPickup,
+		arg.MaxDeliveryFee,
+		arg.MaxPreparationTime,
+		arg.City,
+		arg.State,
+		arg.HasDeliveryArea,
+		arg.OpenNow,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -394,6 +917,337 @@ func (q *Queries) ListRestaurants(ctx context.Context, arg ListRestaurantsParams
 			&i.BannerUrl,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Version,
+			&i.StatusReason,
+			&i.Timezone,
+			&i.SearchVector,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRestaurantsSorted = `-- name: ListRestaurantsSorted :many
+WITH filtered AS NOT MATERIALIZED (
+    SELECT id, name, rating, total_reviews, delivery_fee, min_order_value, preparation_time_min
+    FROM filtered_restaurants(
+        $1::text, $2::text,
+        $3::boolean, $4::boolean,
+        $5::bigint, $6::integer,
+        $7::text, $8::text, $9::boolean,
+        $10::text, CASE WHEN $11::boolean THEN now() END
+    ) restaurants
+), distances AS NOT MATERIALIZED (
+    SELECT f.id, d.distance_m,
+           COALESCE(d.distance_m, 1e15)::float8 AS near_key,
+           COALESCE(-d.distance_m, 1e15)::float8 AS far_key
+    FROM filtered f
+    LEFT JOIN LATERAL (
+        SELECT (2 * 6371000 * asin(least(1, sqrt(
+            power(sin(radians(a.lat - $12::float8) / 2), 2) +
+            cos(radians($12::float8)) * cos(radians(a.lat)) *
+            power(sin(radians(a.lng - $13::float8) / 2), 2)
+        ))))::float8 AS distance_m
+        FROM restaurant_addresses a
+        WHERE a.restaurant_id = f.id
+    ) d ON true
+), page AS (
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY rating, id) AS position
+     FROM filtered
+     WHERE $14::text = 'rating'
+       AND ($15::uuid IS NULL OR (rating, id) > (($16::float8)::integer, $15))
+     ORDER BY rating, id
+     LIMIT $17::integer + $18::integer)
+    UNION ALL
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY rating DESC, id DESC) AS position
+     FROM filtered
+     WHERE $14::text = '-rating'
+       AND ($15::uuid IS NULL OR (rating, id) < ((-$16::float8)::integer, $15))
+     ORDER BY rating DESC, id DESC
+     LIMIT $17::integer + $18::integer)
+    UNION ALL
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY total_reviews, id) AS position
+     FROM filtered
+     WHERE $14::text = 'total_reviews'
+       AND ($15::uuid IS NULL OR (total_reviews, id) > (($16::float8)::integer, $15))
+     ORDER BY total_reviews, id
+     LIMIT $17::integer + $18::integer)
+    UNION ALL
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY total_reviews DESC, id DESC) AS position
+     FROM filtered
+     WHERE $14::text = '-total_reviews'
+       AND ($15::uuid IS NULL OR (total_reviews, id) < ((-$16::float8)::integer, $15))
+     ORDER BY total_reviews DESC, id DESC
+     LIMIT $17::integer + $18::integer)
+    UNION ALL
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY delivery_fee, id) AS position
+     FROM filtered
+     WHERE $14::text = 'delivery_fee'
+       AND ($15::uuid IS NULL OR (delivery_fee, id) > (($16::float8)::bigint, $15))
+     ORDER BY delivery_fee, id
+     LIMIT $17::integer + $18::integer)
+    UNION ALL
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY delivery_fee DESC, id DESC) AS position
+     FROM filtered
+     WHERE $14::text = '-delivery_fee'
+       AND ($15::uuid IS NULL OR (delivery_fee, id) < ((-$16::float8)::bigint, $15))
+     ORDER BY delivery_fee DESC, id DESC
+     LIMIT $17::integer + $18::integer)
+    UNION ALL
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY min_order_value, id) AS position
+     FROM filtered
+     WHERE $14::text = 'min_order_value'
+       AND ($15::uuid IS NULL OR (min_order_value, id) > (($16::float8)::bigint, $15))
+     ORDER BY min_order_value, id
+     LIMIT $17::integer + $18::integer)
+    UNION ALL
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY min_order_value DESC, id DESC) AS position
+     FROM filtered
+     WHERE $14::text = '-min_order_value'
+       AND ($15::uuid IS NULL OR (min_order_value, id) < ((-$16::float8)::bigint, $15))
+     ORDER BY min_order_value DESC, id DESC
+     LIMIT $17::integer + $18::integer)
+    UNION ALL
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY preparation_time_min, id) AS position
+     FROM filtered
+     WHERE $14::text = 'preparation_time_min'
+       AND ($15::uuid IS NULL OR (preparation_time_min, id) > (($16::float8)::integer, $15))
+     ORDER BY preparation_time_min, id
+     LIMIT $17::integer + $18::integer)
+    UNION ALL
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY preparation_time_min DESC, id DESC) AS position
+     FROM filtered
+     WHERE $14::text = '-preparation_time_min'
+       AND ($15::uuid IS NULL OR (preparation_time_min, id) < ((-$16::float8)::integer, $15))
+     ORDER BY preparation_time_min DESC, id DESC
+     LIMIT $17::integer + $18::integer)
+    UNION ALL
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY name, id) AS position
+     FROM filtered
+     WHERE $14::text = 'name'
+       AND ($15::uuid IS NULL OR (name, id) > ($19::text, $15))
+     ORDER BY name, id
+     LIMIT $17::integer + $18::integer)
+    UNION ALL
+    (SELECT id, NULL::float8 AS distance_m, row_number() OVER (ORDER BY name DESC, id DESC) AS position
+     FROM filtered
+     WHERE $14::text = '-name'
+       AND ($15::uuid IS NULL OR (name, id) < ($19::text, $15))
+     ORDER BY name DESC, id DESC
+     LIMIT $17::integer + $18::integer)
+    UNION ALL
+    (SELECT id, distance_m, row_number() OVER (ORDER BY near_key, id) AS position
+     FROM distances
+     WHERE $14::text = 'distance'
+       AND ($15::uuid IS NULL OR (near_key, id) > ($16::float8, $15))
+     ORDER BY near_key, id
+     LIMIT $17::integer + $18::integer)
+    UNION ALL
+    (SELECT id, distance_m, row_number() OVER (ORDER BY far_key, id DESC) AS position
+     FROM distances
+     WHERE $14::text = '-distance'
+       AND ($15::uuid IS NULL OR far_key > $16::float8
+            OR (far_key = $16 AND id < $15))
+     ORDER BY far_key, id DESC
+     LIMIT $17::integer + $18::integer)
+)
+SELECT restaurants.id, restaurants.name, restaurants.slug, restaurants.description, restaurants.status, restaurants.category, restaurants.rating, restaurants.total_reviews, restaurants.delivery_fee, restaurants.min_order_value, restaurants.preparation_time_min, restaurants.supports_pickup, restaurants.supports_delivery, restaurants.logo_url, restaurants.banner_url, restaurants.created_at, restaurants.updated_at, restaurants.version, restaurants.status_reason, restaurants.timezone, restaurants.search_vector, page.distance_m
+FROM page
+JOIN restaurants ON restaurants.id = page.id
+ORDER BY page.position
+LIMIT $17 OFFSET $18
+`
+
+type ListRestaurantsSortedParams struct {
+	Category           pgtype.Text   `json:"category"`
+	Status             pgtype.Text   `json:"status"`
+	SupportsDelivery   pgtype.Bool   `json:"supports_delivery"`
+	SupportsPickup     pgtype.Bool   `json:"supports_pickup"`
+	MaxDeliveryFee     pgtype.Int8   `json:"max_delivery_fee"`
+	MaxPreparationTime pgtype.Int4   `json:"max_preparation_time"`
+	City               pgtype.Text   `json:"city"`
+	State              pgtype.Text   `json:"state"`
+	HasDeliveryArea    pgtype.Bool   `json:"has_delivery_area"`
+	Query              pgtype.Text   `json:"query"`
+	OpenNow            pgtype.Bool   `json:"open_now"`
+	OriginLat          pgtype.Float8 `json:"origin_lat"`
+	OriginLng          pgtype.Float8 `json:"origin_lng"`
+	Sort               string        `json:"sort"`
+	CursorID           pgtype.UUID   `json:"cursor_id"`
+	CursorNumber       pgtype.Float8 `json:"cursor_number"`
+	Limit              int32         `json:"limit"`
+	Offset             int32         `json:"offset"`
+	CursorText         pgtype.Text   `json:"cursor_text"`
+}
+
+type ListRestaurantsSortedRow struct {
+	Restaurant Restaurant    `json:"restaurant"`
+	DistanceM  pgtype.Float8 `json:"distance_m"`
+}
+
+// Listagem com sort explícito: cada ramo atende um sort ("rating", "-rating", ...) com ORDER BY e
+// keyset sobre a própria coluna, usando os índices (campo, id); só o ramo do sort pedido executa
+// Empates são desfeitos por id na mesma direção do campo. cursor_number vem com o sinal da direção
+// Restaurantes sem coordenadas ficam no fim da ordenação por distância (chave 1e15)
+func (q *Queries) ListRestaurantsSorted(ctx context.Context, arg ListRestaurantsSortedParams) ([]ListRestaurantsSortedRow, error) {
+	rows, err := q.db.Query(ctx, listRestaurantsSorted,
+		arg.Category,
+		arg.Status,
+		arg.SupportsDelivery,
+		arg.SupportsPickup,
+		arg.MaxDeliveryFee,
+		arg.MaxPreparationTime,
+		arg.City,
+		arg.State,
+		arg.HasDeliveryArea,
+		arg.Query,
+		arg.OpenNow,
+		arg.OriginLat,
+		arg.OriginLng,
+		arg.Sort,
+		arg.CursorID,
+		arg.CursorNumber,
+		arg.Limit,
+		arg.Offset,
+		arg.CursorText,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRestaurantsSortedRow
+	for rows.Next() {
+		var i ListRestaurantsSortedRow
+		if err := rows.Scan(
+			&i.Restaurant.ID,
+			&i.Restaurant.Name,
+			&i.Restaurant.Slug,
+			&i.Restaurant.Description,
+			&i.Restaurant.Status,
+			&i.Restaurant.Category,
+			&i.Restaurant.Rating,
+			&i.Restaurant.TotalReviews,
+			&i.Restaurant.DeliveryFee,
+			&i.Restaurant.MinOrderValue,
+			&i.Restaurant.PreparationTimeMin,
+			&i.Restaurant.SupportsPickup,
+			&i.Restaurant.SupportsDelivery,
+			&i.Restaurant.LogoUrl,
+			&i.Restaurant.BannerUrl,
+			&i.Restaurant.CreatedAt,
+			&i.Restaurant.UpdatedAt,
+			&i.Restaurant.Version,
+			&i.Restaurant.StatusReason,
+			&i.Restaurant.Timezone,
+			&i.Restaurant.SearchVector,
+			&i.DistanceM,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchRestaurants = `-- name: SearchRestaurants :many
+SELECT restaurants.id, restaurants.name, restaurants.slug, restaurants.description, restaurants.status, restaurants.category, restaurants.rating, restaurants.total_reviews, restaurants.delivery_fee, restaurants.min_order_value, restaurants.preparation_time_min, restaurants.supports_pickup, restaurants.supports_delivery, restaurants.logo_url, restaurants.banner_url, restaurants.created_at, restaurants.updated_at, restaurants.version, restaurants.status_reason, restaurants.timezone, restaurants.search_vector,
+       ts_rank(search_vector, websearch_to_tsquery('portuguese_unaccent', $1::text)) AS search_rank
+FROM filtered_restaurants(
+    $2::text, $3::text,
+    $4::boolean, $5::boolean,
+    $6::bigint, $7::integer,
+    $8::text, $9::text, $10::boolean,
+    $1::text, CASE WHEN $11::boolean THEN now() END
+) restaurants
+WHERE ($12::timestamp IS NULL
+       OR (ts_rank(search_vector, websearch_to_tsquery('portuguese_unaccent', $1::text)), created_at, id)
+          < ($13::real, $12, $14::uuid))
+ORDER BY search_rank DESC, created_at DESC, id DESC
+LIMIT $15 OFFSET $16
+`
+
+type SearchRestaurantsParams struct {
+	Query              string           `json:"query"`
+	Category           pgtype.Text      `json:"category"`
+	Status             pgtype.Text      `json:"status"`
+	SupportsDelivery   pgtype.Bool      `json:"supports_delivery"`
+	SupportsPickup     pgtype.Bool      `json:"supports_pickup"`
+	MaxDeliveryFee     pgtype.Int8      `json:"max_delivery_fee"`
+	MaxPreparationTime pgtype.Int4      `json:"max_preparation_time"`
+	City               pgtype.Text      `json:"city"`
+	State              pgtype.Text      `json:"state"`
+	HasDeliveryArea    pgtype.Bool      `json:"has_delivery_area"`
+	OpenNow            pgtype.Bool      `json:"open_now"`
+	CursorCreatedAt    pgtype.Timestamp `json:"cursor_created_at"`
+	CursorRank         pgtype.Float4    `json:"cursor_rank"`
+	CursorID           pgtype.UUID      `json:"cursor_id"`
+	Limit              int32            `json:"limit"`
+	Offset             int32            `json:"offset"`
+}
+
+type SearchRestaurantsRow struct {
+	Restaurant Restaurant `json:"restaurant"`
+	SearchRank float32    `json:"search_rank"`
+}
+
+// Busca textual ordenada por relevância; o cursor inclui o rank para manter o keyset estável
+func (q *Queries) SearchRestaurants(ctx context.Context, arg SearchRestaurantsParams) ([]SearchRestaurantsRow, error) {
+	rows, err := q.db.Query(ctx, searchRestaurants,
+		arg.Query,
+		arg.Category,
+		arg.Status,
+		arg.SupportsDelivery,
+		arg.SupportsPickup,
+		arg.MaxDeliveryFee,
+		arg.MaxPreparationTime,
+		arg.City,
+		arg.State,
+		arg.HasDeliveryArea,
+		arg.OpenNow,
+		arg.CursorCreatedAt,
+		arg.CursorRank,
+		arg.CursorID,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchRestaurantsRow
+	for rows.Next() {
+		var i SearchRestaurantsRow
+		if err := rows.Scan(
+			&i.Restaurant.ID,
+			&i.Restaurant.Name,
+			&i.Restaurant.Slug,
+			&i.Restaurant.Description,
+			&i.Restaurant.Status,
+			&i.Restaurant.Category,
+			&i.Restaurant.Rating,
+			&i.Restaurant.TotalReviews,
+			&i.Restaurant.DeliveryFee,
+			&i.Restaurant.MinOrderValue,
+			&i.Restaurant.PreparationTimeMin,
+			&i.Restaurant.SupportsPickup,
+			&i.Restaurant.SupportsDelivery,
+			&i.Restaurant.LogoUrl,
+			&i.Restaurant.BannerUrl,
+			&i.Restaurant.CreatedAt,
+			&i.Restaurant.UpdatedAt,
+			&i.Restaurant.Version,
+			&i.Restaurant.StatusReason,
+			&i.Restaurant.Timezone,
+			&i.Restaurant.SearchVector,
+			&i.SearchRank,
 		); err != nil {
 			return nil, err
 		}
@@ -413,15 +1267,15 @@ RETURNING id, restaurant_id, street, number, complement, city, state, zip_code,
 `
 
 type UpdateRestaurantAddressParams struct {
-	RestaurantID uuid.UUID   `json:"restaurant_id"`
-	Street       string      `json:"street"`
-	Number       string      `json:"number"`
-	Complement   pgtype.Text `json:"complement"`
-	City         string      `json:"city"`
-	State        string      `json:"state"`
-	ZipCode      string      `json:"zip_code"`
-	Lat          float64     `json:"lat"`
-	Lng          float64     `json:"lng"`
+	RestaurantID uuid.UUID     `json:"restaurant_id"`
+	Street       string        `json:"street"`
+	Number       string        `json:"number"`
+	Complement   pgtype.Text   `json:"complement"`
+	City         string        `json:"city"`
+	State        string        `json:"state"`
+	ZipCode      string        `json:"zip_code"`
+	Lat          pgtype.Float8 `json:"lat"`
+	Lng          pgtype.Float8 `json:"lng"`
 }
 
 func (q *Queries) UpdateRestaurantAddress(ctx context.Context, arg UpdateRestaurantAddressParams) (RestaurantAddress, error) {
@@ -454,20 +1308,49 @@ func (q *Queries) UpdateRestaurantAddress(ctx context.Context, arg UpdateRestaur
 	return i, err
 }
 
-const updateRestaurantStatus = `-- name: UpdateRestaurantStatus :one
+const updateRestaurantProfile = `-- name: UpdateRestaurantProfile :one
 UPDATE restaurants
-SET status = $2, updated_at = NOW()
-WHERE id = $1
-RETURNING id, name, slug, description, status, category, rating, total_reviews, delivery_fee, min_order_value, preparation_time_min, supports_pickup, supports_delivery, logo_url, banner_url, created_at, updated_at
+SET name = $2, slug = $3, description = $4, category = $5, delivery_fee = $6, min_order_value = $7,
+    preparation_time_min = $8, supports_pickup = $9, supports_delivery = $10,
+    logo_url = $11, banner_url = $12, timezone = $13, version = version + 1, updated_at = NOW()
+WHERE id = $1 AND version = $14
+RETURNING id, name, slug, description, status, category, rating, total_reviews, delivery_fee, min_order_value, preparation_time_min, supports_pickup, supports_delivery, logo_url, banner_url, created_at, updated_at, version, status_reason, timezone, search_vector
 `
 
-type UpdateRestaurantStatusParams struct {
-	ID     uuid.UUID `json:"id"`
-	Status string    `json:"status"`
+type UpdateRestaurantProfileParams struct {
+	ID                 uuid.UUID   `json:"id"`
+	Name               string      `json:"name"`
+	Slug               string      `json:"slug"`
+	Description        pgtype.Text `json:"description"`
+	Category           pgtype.Text `json:"category"`
+	DeliveryFee        int64       `json:"delivery_fee"`
+	MinOrderValue      int64       `json:"min_order_value"`
+	PreparationTimeMin int32       `json:"preparation_time_min"`
+	SupportsPickup     bool        `json:"supports_pickup"`
+	SupportsDelivery   bool        `json:"supports_delivery"`
+	LogoUrl            pgtype.Text `json:"logo_url"`
+	BannerUrl          pgtype.Text `json:"banner_url"`
+	Timezone           pgtype.Text `json:"timezone"`
+	Version            int32       `json:"version"`
 }
 
-func (q *Queries) UpdateRestaurantStatus(ctx context.Context, arg UpdateRestaurantStatusParams) (Restaurant, error) {
-	row := q.db.QueryRow(ctx, updateRestaurantStatus, arg.ID, arg.Status)
+func (q *Queries) UpdateRestaurantProfile(ctx context.Context, arg UpdateRestaurantProfileParams) (Restaurant, error) {
+	row := q.db.QueryRow(ctx, updateRestaurantProfile,
+		arg.ID,
+		arg.Name,
+		arg.Slug,
+		arg.Description,
+		arg.Category,
+		arg.DeliveryFee,
+		arg.MinOrderValue,
+		arg.PreparationTimeMin,
+		arg.SupportsPickup,
+		arg.SupportsDelivery,
+		arg.LogoUrl,
+		arg.BannerUrl,
+		arg.Timezone,
+		arg.Version,
+	)
 	var i Restaurant
 	err := row.Scan(
 		&i.ID,
@@ -487,6 +1370,37 @@ func (q *Queries) UpdateRestaurantStatus(ctx context.Context, arg UpdateRestaura
 		&i.BannerUrl,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.StatusReason,
+		&i.Timezone,
+		&i.SearchVector,
 	)
 	return i, err
 }
+
+const updateRestaurantStatus = `-- name: UpdateRestaurantStatus :execrows
+UPDATE restaurants
+SET status = $2, status_reason = $3, version = version + 1, updated_at = NOW()
+WHERE id = $1 AND status = $4
+`
+
+type UpdateRestaurantStatusParams struct {
+	ID           uuid.UUID   `json:"id"`
+	Status       string      `json:"status"`
+	StatusReason pgtype.Text `json:"status_reason"`
+	FromStatus   string      `json:"from_status"`
+}
+
+// Só transiciona se o status ainda for o lido (from_status); 0 linhas = alterado por outra requisição
+func (q *Queries) UpdateRestaurantStatus(ctx context.Context, arg UpdateRestaurantStatusParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateRestaurantStatus,
+		arg.ID,
+		arg.Status,
+		arg.StatusReason,
+		arg.FromStatus,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,18 @@
+package domain
+
+// Papéis de quem executa uma ação
+const (
+	RoleMerchant = "merchant"
+	RoleAdmin    = "admin"
+)
+
+// Actor identifica quem executa uma ação (usado em autorização e auditoria)
+type Actor struct {
+	ID   string `json:"id"`
+	Role string `json:"role"` // "merchant", "admin"
+}
+
+// IsAdmin informa se o ator é um administrador da plataforma
+func (a Actor) IsAdmin() bool {
+	return a.Role == RoleAdmin
+}
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// validStates contém as 27 unidades federativas brasileiras
+var validStates = map[string]bool{
+	"AC": true, "AL": true, "AP": true, "AM": true, "BA": true, "CE": true, "DF": true,
+	"ES": true, "GO": true, "MA": true, "MT": true, "MS": true, "MG": true, "PA": true,
+	"PB": true, "PR": true, "PE": true, "PI": true, "RJ": true, "RN": true, "RS": true,
+	"RO": true, "RR": true, "SC": true, "SP": true, "SE": true, "TO": true,
+}
+
+// IsValidState informa se a sigla é uma UF brasileira válida
+func IsValidState(state string) bool {
+	return validStates[state]
+}
+
+// Normalize padroniza UF (maiúsculas) e CEP (apenas dígitos, aceitando "01310-100")
+func (a *Address) Normalize() {
+	a.Street = strings.TrimSpace(a.Street)
+	a.Number = strings.TrimSpace(a.Number)
+	a.City = strings.TrimSpace(a.City)
+	a.State = strings.ToUpper(strings.TrimSpace(a.State))
+	a.ZipCode = strings.Replace(strings.TrimSpace(a.ZipCode), "-", "", 1)
+}
+
+// HasCoordinates informa se latitude e longitude foram informadas
+func (a *Address) HasCoordinates() bool {
+	return a.Lat != nil && a.Lng != nil
+}
+
+// Validate valida um endereço brasileiro
+// requireCoordinates deve ser true quando o restaurante está OPEN (R3)
+func (a *Address) Validate(requireCoordinates bool) error {
+	if a.Street == "" {
+		return NewValidationError("street_required", "street is required")
+	}
+	if a.Number == "" {
+		return NewValidationError("number_required", "number is required")
+	}
+	if a.City == "" {
+		return NewValidationError("city_required", "city is required")
+	}
+	if !IsValidState(a.State) {
+		return NewValidationError("invalid_state", fmt.Sprintf("invalid state: %s", a.State))
+	}
+	if !isCEP(a.ZipCode) {
+		return NewValidationError("invalid_zip_code", "zip code must have 8 digits")
+	}
+
+	if (a.Lat == nil) != (a.Lng == nil) {
+		return NewValidationError("incomplete_coordinates", "lat and lng must be informed together")
+	}
+	if requireCoordinates && !a.HasCoordinates() {
+		return NewValidationError("coordinates_required", "lat and lng are required for open restaurants")
+	}
+	if a.Lat != nil && (*a.Lat < -90 || *a.Lat > 90) {
+		return NewValidationError("invalid_lat", "lat must be between -90 and 90")
+	}
+	if a.Lng != nil && (*a.Lng < -180 || *a.Lng > 180) {
+		return NewValidationError("invalid_lng", "lng must be between -180 and 180")
+	}
+
+	return nil
+}
+
+// isCEP verifica se o CEP tem exatamente 8 dígitos
+func isCEP(zipCode string) bool {
+	if len(zipCode) != 8 {
+		return false
+	}
+	for _, r := range zipCode {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
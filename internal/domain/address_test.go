@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validAddress() *Address {
+	return &Address{
+		Street:  "Av. Paulista",
+		Number:  "1000",
+		City:    "São Paulo",
+		State:   "sp",
+		ZipCode: "01310-100",
+	}
+}
+
+func TestAddress_Validate_DraftWithoutCoordinates(t *testing.T) {
+	// Input
+	address := validAddress()
+	address.Normalize()
+
+	// Output
+	err := address.Validate(false)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "SP", address.State)
+	assert.Equal(t, "01310100", address.ZipCode)
+}
+
+func TestAddress_Validate_Errors(t *testing.T) {
+	lat, lng := -23.561, -46.656
+	outOfRange := 91.0
+
+	tests := []struct {
+		name               string
+		mutate             func(a *Address)
+		requireCoordinates bool
+		code               string
+	}{
+		{"invalid CEP", func(a *Address) { a.ZipCode = "1234567" }, false, "invalid_zip_code"},
+		{"CEP with letters", func(a *Address) { a.ZipCode = "0131010A" }, false, "invalid_zip_code"},
+		{"invalid UF", func(a *Address) { a.State = "XX" }, false, "invalid_state"},
+		{"missing coordinates when open", func(a *Address) {}, true, "coordinates_required"},
+		{"lat without lng", func(a *Address) { a.Lat = &lat }, false, "incomplete_coordinates"},
+		{"lat out of range", func(a *Address) { a.Lat, a.Lng = &outOfRange, &lng }, false, "invalid_lat"},
+		{"lng out of range", func(a *Address) { l := -180.5; a.Lat, a.Lng = &lat, &l }, false, "invalid_lng"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Input
+			address := validAddress()
+			address.Normalize()
+			tt.mutate(address)
+
+			// Output
+			err := address.Validate(tt.requireCoordinates)
+
+			// Assert
+			var domainErr *Error
+			assert.ErrorAs(t, err, &domainErr)
+			assert.ErrorIs(t, err, ErrValidation)
+			assert.Equal(t, tt.code, domainErr.Code)
+		})
+	}
+}
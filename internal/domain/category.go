@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Limites da taxonomia de categorias
+const (
+	MaxCategoryNameLength   = 100
+	MaxCategoryIconLength   = 255
+	MaxRestaurantCategories = 5
+)
+
+// Category é uma categoria da taxonomia (ex: "Pizza", "Japonesa", "Poke")
+type Category struct {
+	ID        uuid.UUID `json:"id"`
+	Slug      string    `json:"slug"` // "comida-japonesa" (Unique)
+	Name      string    `json:"name"`
+	Icon      string    `json:"icon,omitempty"` // URL ou identificador do ícone; não obrigatório
+	Position  int       `json:"position"`       // Ordem de exibição (crescente)
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Validate valida os dados da categoria
+func (c *Category) Validate() error {
+	c.Name = strings.TrimSpace(c.Name)
+	if c.Name == "" {
+		return NewValidationError("category_name_required", "category name is required")
+	}
+	if len(c.Name) > MaxCategoryNameLength {
+		return NewValidationError("invalid_category_name", fmt.Sprintf("category name must have at most %d characters", MaxCategoryNameLength))
+	}
+	if !slugPattern.MatchString(c.Slug) || len(c.Slug) > MaxCategoryNameLength {
+		return NewValidationError("invalid_category_slug", fmt.Sprintf("invalid category slug: %s", c.Slug))
+	}
+	if len(c.Icon) > MaxCategoryIconLength {
+		return NewValidationError("invalid_category_icon", fmt.Sprintf("category icon must have at most %d characters", MaxCategoryIconLength))
+	}
+	if c.Position < 0 {
+		return NewValidationError("invalid_category_position", "category position cannot be negative")
+	}
+	return nil
+}
+
+// Matches informa se a categoria corresponde ao filtro (slug ou nome, sem diferenciar maiúsculas)
+// Mesma regra do filtro category da listagem no banco
+func (c Category) Matches(ref string) bool {
+	return c.Slug == strings.ToLower(ref) || strings.EqualFold(c.Name, ref)
+}
+
+// HasCategory informa se alguma categoria do restaurante corresponde ao filtro
+func (r *Restaurant) HasCategory(ref string) bool {
+	for _, category := range r.Categories {
+		if category.Matches(ref) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetCategories define as categorias do restaurante; a primeira é a principal
+func (r *Restaurant) SetCategories(categories []Category) error {
+	if len(categories) > MaxRestaurantCategories {
+		return NewValidationError("too_many_categories", fmt.Sprintf("a restaurant can have at most %d categories", MaxRestaurantCategories))
+	}
+
+	r.Categories = categories
+	r.Category = ""
+	if len(categories) > 0 {
+		r.Category = categories[0].Name
+	}
+	return nil
+}
+
+// CanManageCategories verifica se o ator pode alterar a taxonomia (somente admin)
+func CanManageCategories(actor Actor) error {
+	if !actor.IsAdmin() {
+		return NewForbiddenError("admin_required", "only admins can manage categories")
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCategory_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		category Category
+		code     string
+	}{
+		{name: "valid", category: Category{Name: " Comida Japonesa ", Slug: "comida-japonesa", Position: 2}},
+		{name: "missing name", category: Category{Name: "  ", Slug: "pizza"}, code: "category_name_required"},
+		{name: "name too long", category: Category{Name: strings.Repeat("a", 101), Slug: "pizza"}, code: "invalid_category_name"},
+		{name: "uppercase slug", category: Category{Name: "Pizza", Slug: "Pizza"}, code: "invalid_category_slug"},
+		{name: "trailing hyphen", category: Category{Name: "Pizza", Slug: "pizza-"}, code: "invalid_category_slug"},
+		{name: "negative position", category: Category{Name: "Pizza", Slug: "pizza", Position: -1}, code: "invalid_category_position"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Output
+			err := tt.category.Validate()
+
+			// Assert
+			if tt.code == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, "Comida Japonesa", tt.category.Name)
+				return
+			}
+			var domainErr *Error
+			assert.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, tt.code, domainErr.Code)
+		})
+	}
+}
+
+func TestRestaurant_SetCategories(t *testing.T) {
+	// Input
+	restaurant := &Restaurant{Category: "Antiga"}
+	pizza := Category{Slug: "pizza", Name: "Pizza"}
+	italiana := Category{Slug: "italiana", Name: "Italiana"}
+
+	// Output
+	err := restaurant.SetCategories([]Category{pizza, italiana})
+	tooMany := restaurant.SetCategories(make([]Category, MaxRestaurantCategories+1))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Pizza", restaurant.Category)
+	assert.True(t, restaurant.HasCategory("ITALIANA"))
+	assert.True(t, restaurant.HasCategory("pizza"))
+	assert.False(t, restaurant.HasCategory("japonesa"))
+	assert.ErrorIs(t, tooMany, ErrValidation)
+}
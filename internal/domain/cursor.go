@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ListCursor identifica a posição de um restaurante na ordenação da listagem
+// Na ordenação padrão a posição é (created_at DESC, id DESC); na busca textual começa pela
+// relevância (Rank DESC); com sort, usa as chaves de ordenação e desempata por id
+// A próxima página começa estritamente depois dessa posição
+type ListCursor struct {
+	Sort       string    `json:"s,omitempty"` // Forma canônica do sort para o qual o cursor foi gerado
+	Rank       float32   `json:"r,omitempty"` // Relevância da busca textual; 0 fora da busca
+	SortNumber float64   `json:"n,omitempty"` // Chave numérica (com sinal da direção) quando há sort
+	SortText   *string   `json:"x,omitempty"` // Chave textual quando sort=name
+	Origin     *GeoPoint `json:"o,omitempty"` // Ponto de referência quando sort=distance
+	CreatedAt  time.Time `json:"c"`
+	ID         uuid.UUID `json:"i"`
+}
+
+// CursorFor retorna o cursor que aponta para depois do restaurante na ordenação informada
+func CursorFor(restaurant *Restaurant, sort RestaurantSort) ListCursor {
+	cursor := ListCursor{Sort: sort.String(), CreatedAt: restaurant.CreatedAt, ID: restaurant.ID}
+	if restaurant.SearchRank != nil {
+		cursor.Rank = *restaurant.SearchRank
+	}
+	if !sort.IsDefault() {
+		cursor.SortNumber, cursor.SortText = sort.keys(restaurant)
+	}
+	if sort.Field == SortDistance {
+		cursor.Origin = sort.Origin
+	}
+	return cursor
+}
+
+// Encode gera o token opaco do cursor (base64url de um JSON compacto)
+func (c ListCursor) Encode() (string, error) {
+	raw, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("encode cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// CheckSort garante que o cursor foi gerado para a mesma ordenação (e o mesmo ponto de
+// referência, na ordenação por distância); as chaves de outra ordenação não posicionam a página
+func (c ListCursor) CheckSort(sort RestaurantSort) error {
+	if c.Sort != sort.String() {
+		return NewValidationError("cursor_sort_mismatch", "cursor was issued for a different sort")
+	}
+	if sort.Field == SortDistance && (c.Origin == nil || *c.Origin != *sort.Origin) {
+		return NewValidationError("cursor_origin_mismatch", "cursor was issued for a different origin")
+	}
+	return nil
+}
+
+// DecodeCursor interpreta um token gerado por ListCursor.Encode
+func DecodeCursor(token string) (*ListCursor, error) {
+	invalid := NewValidationError("invalid_cursor", "invalid cursor")
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalid
+	}
+
+	var cursor ListCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil || cursor.ID == uuid.Nil {
+		return nil, invalid
+	}
+
+	return &cursor, nil
+}
+
+// String facilita logs e mensagens de erro
+func (c ListCursor) String() string {
+	return fmt.Sprintf("%s/%s", c.CreatedAt.Format(time.RFC3339Nano), c.ID)
+}
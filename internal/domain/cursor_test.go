@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCursor_EncodeDecode_RoundTrip(t *testing.T) {
+	// Input
+	cursor := ListCursor{
+		CreatedAt: time.Date(2024, time.March, 4, 11, 30, 0, 123456000, time.UTC),
+		ID:        uuid.New(),
+	}
+
+	// Output
+	token, err := cursor.Encode()
+	require.NoError(t, err)
+	decoded, err := DecodeCursor(token)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, cursor.CreatedAt.Equal(decoded.CreatedAt))
+	assert.Equal(t, cursor.ID, decoded.ID)
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	// Input
+	tokens := []string{"", "%%%", "bm8tc2VwYXJhdG9y", "YWJjOg", "MTIzOm5vdC1hLXV1aWQ"}
+
+	for _, token := range tokens {
+		// Output
+		cursor, err := DecodeCursor(token)
+
+		// Assert
+		assert.Nil(t, cursor, token)
+		assert.ErrorIs(t, err, ErrValidation, token)
+	}
+}
+
+func TestListCursor_EncodeDecode_WithRank(t *testing.T) {
+	// Input
+	rank := float32(0.0607927)
+	restaurant := &Restaurant{
+		ID:         uuid.New(),
+		CreatedAt:  time.Date(2024, time.March, 4, 11, 30, 0, 0, time.UTC),
+		SearchRank: &rank,
+	}
+
+	// Output
+	token, err := CursorFor(restaurant, RestaurantSort{}).Encode()
+	require.NoError(t, err)
+	decoded, err := DecodeCursor(token)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, rank, decoded.Rank)
+	assert.Equal(t, restaurant.ID, decoded.ID)
+}
+
+func TestListCursor_Encode_NotSerializable(t *testing.T) {
+	// Input
+	cursor := ListCursor{SortNumber: math.NaN(), ID: uuid.New()}
+
+	// Output
+	token, err := cursor.Encode()
+
+	// Assert
+	assert.Error(t, err)
+	assert.Empty(t, token)
+}
+
+func TestListCursor_CheckSort(t *testing.T) {
+	// Input
+	origin := GeoPoint{Lat: -23.55, Lng: -46.63}
+	byDistance := RestaurantSort{Field: SortDistance, Origin: &origin}
+	restaurant := &Restaurant{ID: uuid.New()}
+	cursor := CursorFor(restaurant, byDistance)
+	token, err := cursor.Encode()
+	require.NoError(t, err)
+	decoded, err := DecodeCursor(token)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		sort     RestaurantSort
+		expected string
+	}{
+		{name: "same sort and origin", sort: byDistance},
+		{name: "other sort", sort: RestaurantSort{Field: SortRating}, expected: "cursor_sort_mismatch"},
+		{name: "other origin", sort: RestaurantSort{Field: SortDistance, Origin: &GeoPoint{Lat: -22.90, Lng: -43.17}}, expected: "cursor_origin_mismatch"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Output
+			err := decoded.CheckSort(tt.sort)
+
+			// Assert
+			if tt.expected == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, ErrValidation)
+			var domainErr *Error
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, tt.expected, domainErr.Code)
+		})
+	}
+}
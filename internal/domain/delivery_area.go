@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Tipos de área de entrega
+const (
+	DeliveryAreaRadius  = "RADIUS"  // Raio ao redor das coordenadas do endereço
+	DeliveryAreaPolygon = "POLYGON" // Polígono GeoJSON
+)
+
+const (
+	// MaxDeliveryRadiusMeters limita o raio de uma área de entrega
+	MaxDeliveryRadiusMeters = 50000
+
+	// MaxDeliveryAreas limita quantas áreas um restaurante pode ter
+	MaxDeliveryAreas = 20
+
+	// maxPolygonPositions limita o número de vértices de cada anel do polígono
+	maxPolygonPositions = 1000
+)
+
+// DeliveryArea representa uma região atendida pelo delivery do restaurante
+type DeliveryArea struct {
+	ID           uuid.UUID       `json:"id"`
+	RestaurantID uuid.UUID       `json:"restaurant_id"`
+	Kind         string          `json:"kind"`               // "RADIUS" ou "POLYGON"
+	RadiusMeters int             `json:"radius_m,omitempty"` // Apenas RADIUS
+	Polygon      *GeoJSONPolygon `json:"polygon,omitempty"`  // Apenas POLYGON
+}
+
+// GeoJSONPolygon é uma geometria GeoJSON do tipo Polygon (RFC 7946)
+// O primeiro anel é o contorno externo; os demais são buracos. Posições são [lng, lat]
+type GeoJSONPolygon struct {
+	Type        string        `json:"type"`
+	Coordinates [][][]float64 `json:"coordinates"`
+}
+
+// Validate valida o tipo e os parâmetros da área
+func (a *DeliveryArea) Validate() error {
+	switch a.Kind {
+	case DeliveryAreaRadius:
+		if a.Polygon != nil {
+			return NewValidationError("invalid_delivery_area", "radius areas cannot have a polygon")
+		}
+		if a.RadiusMeters <= 0 || a.RadiusMeters > MaxDeliveryRadiusMeters {
+			return NewValidationError("invalid_delivery_radius", fmt.Sprintf("radius_m must be between 1 and %d", MaxDeliveryRadiusMeters))
+		}
+	case DeliveryAreaPolygon:
+		if a.RadiusMeters != 0 {
+			return NewValidationError("invalid_delivery_area", "polygon areas cannot have a radius")
+		}
+		if a.Polygon == nil {
+			return NewValidationError("polygon_required", "polygon is required")
+		}
+		return a.Polygon.Validate()
+	default:
+		return NewValidationError("invalid_delivery_area_kind", fmt.Sprintf("invalid delivery area kind: %s", a.Kind))
+	}
+	return nil
+}
+
+// Validate verifica se o polígono é um Polygon GeoJSON com anéis fechados e coordenadas válidas
+func (p *GeoJSONPolygon) Validate() error {
+	if p.Type != "Polygon" {
+		return NewValidationError("invalid_polygon", "polygon type must be Polygon")
+	}
+	if len(p.Coordinates) == 0 {
+		return NewValidationError("invalid_polygon", "polygon must have at least one ring")
+	}
+
+	for _, ring := range p.Coordinates {
+		if len(ring) < 4 || len(ring) > maxPolygonPositions {
+			return NewValidationError("invalid_polygon", fmt.Sprintf("polygon rings must have between 4 and %d positions", maxPolygonPositions))
+		}
+		for _, position := range ring {
+			if len(position) < 2 {
+				return NewValidationError("invalid_polygon", "positions must be [lng, lat]")
+			}
+			if !(position[0] >= -180 && position[0] <= 180) || !(position[1] >= -90 && position[1] <= 90) {
+				return NewValidationError("invalid_polygon", "polygon coordinates out of range")
+			}
+		}
+		first, last := ring[0], ring[len(ring)-1]
+		if first[0] != last[0] || first[1] != last[1] {
+			return NewValidationError("invalid_polygon", "polygon rings must be closed")
+		}
+	}
+
+	return nil
+}
+
+// Contains informa se o ponto está dentro do contorno externo e fora dos buracos
+// Usa ray casting no plano lng/lat, adequado para áreas do tamanho de uma cidade
+func (p *GeoJSONPolygon) Contains(point GeoPoint) bool {
+	if len(p.Coordinates) == 0 || !ringContains(p.Coordinates[0], point) {
+		return false
+	}
+	for _, hole := range p.Coordinates[1:] {
+		if ringContains(hole, point) {
+			return false
+		}
+	}
+	return true
+}
+
+// ringContains aplica ray casting (regra par-ímpar) a um anel fechado
+func ringContains(ring [][]float64, point GeoPoint) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		xi, yi := ring[i][0], ring[i][1]
+		xj, yj := ring[j][0], ring[j][1]
+		if (yi > point.Lat) != (yj > point.Lat) &&
+			point.Lng < (xj-xi)*(point.Lat-yi)/(yj-yi)+xi {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// Covers informa se a área atende o ponto
+// Áreas de raio dependem das coordenadas do endereço; sem elas, não atendem nenhum ponto
+func (a *DeliveryArea) Covers(address *Address, point GeoPoint) bool {
+	switch a.Kind {
+	case DeliveryAreaRadius:
+		if address == nil || !address.HasCoordinates() {
+			return false
+		}
+		return HaversineMeters(*address.Lat, *address.Lng, point.Lat, point.Lng) <= float64(a.RadiusMeters)
+	case DeliveryAreaPolygon:
+		return a.Polygon != nil && a.Polygon.Contains(point)
+	}
+	return false
+}
+
+// ValidateDeliveryAreas valida o conjunto de áreas de um restaurante
+// Áreas de raio exigem que o endereço tenha coordenadas
+func ValidateDeliveryAreas(areas []DeliveryArea, address *Address) error {
+	if len(areas) > MaxDeliveryAreas {
+		return NewValidationError("too_many_delivery_areas", fmt.Sprintf("at most %d delivery areas are allowed", MaxDeliveryAreas))
+	}
+
+	for i := range areas {
+		if err := areas[i].Validate(); err != nil {
+			return err
+		}
+		if areas[i].Kind == DeliveryAreaRadius && (address == nil || !address.HasCoordinates()) {
+			return NewValidationError("coordinates_required", "radius delivery areas require an address with lat and lng")
+		}
+	}
+
+	return nil
+}
+
+// DeliversTo informa se o restaurante entrega no ponto
+// Exige SupportsDelivery e ao menos uma área que cubra o ponto
+func (r *Restaurant) DeliversTo(point GeoPoint) bool {
+	if !r.SupportsDelivery {
+		return false
+	}
+	for i := range r.DeliveryAreas {
+		if r.DeliveryAreas[i].Covers(r.Address, point) {
+			return true
+		}
+	}
+	return false
+}
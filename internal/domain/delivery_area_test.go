@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// squarePolygon cria um quadrado de 0,02° ao redor da Av. Paulista, com um buraco no centro
+func squarePolygon() *GeoJSONPolygon {
+	return &GeoJSONPolygon{
+		Type: "Polygon",
+		Coordinates: [][][]float64{
+			{{-46.67, -23.57}, {-46.65, -23.57}, {-46.65, -23.55}, {-46.67, -23.55}, {-46.67, -23.57}},
+			{{-46.661, -23.561}, {-46.659, -23.561}, {-46.659, -23.559}, {-46.661, -23.559}, {-46.661, -23.561}},
+		},
+	}
+}
+
+func TestGeoJSONPolygon_Contains(t *testing.T) {
+	// Input
+	polygon := squarePolygon()
+
+	tests := []struct {
+		name  string
+		point GeoPoint
+		want  bool
+	}{
+		{name: "inside", point: GeoPoint{Lat: -23.565, Lng: -46.665}, want: true},
+		{name: "outside", point: GeoPoint{Lat: -23.545, Lng: -46.665}, want: false},
+		{name: "inside hole", point: GeoPoint{Lat: -23.56, Lng: -46.66}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Output
+			got := polygon.Contains(tt.point)
+
+			// Assert
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRestaurant_DeliversTo(t *testing.T) {
+	// Input: restaurante na Av. Paulista com raio de 2 km
+	lat, lng := -23.5614, -46.6559
+	restaurant := &Restaurant{
+		SupportsDelivery: true,
+		Address:          &Address{Lat: &lat, Lng: &lng},
+		DeliveryAreas:    []DeliveryArea{{Kind: DeliveryAreaRadius, RadiusMeters: 2000}},
+	}
+	near := GeoPoint{Lat: -23.5650, Lng: -46.6600}   // ~600 m
+	far := GeoPoint{Lat: -23.6000, Lng: -46.6900}    // ~5 km
+	inPolygon := GeoPoint{Lat: -23.60, Lng: -46.685} // dentro do polígono adicional
+
+	// Output / Assert
+	assert.True(t, restaurant.DeliversTo(near))
+	assert.False(t, restaurant.DeliversTo(far))
+
+	restaurant.DeliveryAreas = append(restaurant.DeliveryAreas, DeliveryArea{
+		Kind: DeliveryAreaPolygon,
+		Polygon: &GeoJSONPolygon{Type: "Polygon", Coordinates: [][][]float64{
+			{{-46.70, -23.61}, {-46.68, -23.61}, {-46.68, -23.59}, {-46.70, -23.59}, {-46.70, -23.61}},
+		}},
+	})
+	assert.True(t, restaurant.DeliversTo(inPolygon))
+
+	restaurant.SupportsDelivery = false
+	assert.False(t, restaurant.DeliversTo(near))
+}
+
+func TestValidateDeliveryAreas(t *testing.T) {
+	lat, lng := -23.5614, -46.6559
+	withCoordinates := &Address{Lat: &lat, Lng: &lng}
+	openRing := squarePolygon()
+	openRing.Coordinates[0] = openRing.Coordinates[0][:4]
+
+	tests := []struct {
+		name    string
+		areas   []DeliveryArea
+		address *Address
+		code    string
+	}{
+		{name: "valid", areas: []DeliveryArea{{Kind: DeliveryAreaRadius, RadiusMeters: 3000}, {Kind: DeliveryAreaPolygon, Polygon: squarePolygon()}}, address: withCoordinates},
+		{name: "invalid kind", areas: []DeliveryArea{{Kind: "CIRCLE"}}, address: withCoordinates, code: "invalid_delivery_area_kind"},
+		{name: "radius too large", areas: []DeliveryArea{{Kind: DeliveryAreaRadius, RadiusMeters: 60000}}, address: withCoordinates, code: "invalid_delivery_radius"},
+		{name: "radius without coordinates", areas: []DeliveryArea{{Kind: DeliveryAreaRadius, RadiusMeters: 3000}}, address: &Address{}, code: "coordinates_required"},
+		{name: "polygon required", areas: []DeliveryArea{{Kind: DeliveryAreaPolygon}}, code: "polygon_required"},
+		{name: "wrong geometry", areas: []DeliveryArea{{Kind: DeliveryAreaPolygon, Polygon: &GeoJSONPolygon{Type: "Point"}}}, code: "invalid_polygon"},
+		{name: "open ring", areas: []DeliveryArea{{Kind: DeliveryAreaPolygon, Polygon: openRing}}, code: "invalid_polygon"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Output
+			err := ValidateDeliveryAreas(tt.areas, tt.address)
+
+			// Assert
+			if tt.code == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var domainErr *Error
+			assert.ErrorAs(t, err, &domainErr)
+			assert.ErrorIs(t, err, ErrValidation)
+			assert.Equal(t, tt.code, domainErr.Code)
+		})
+	}
+}
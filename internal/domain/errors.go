@@ -0,0 +1,107 @@
+package domain
+
+import "errors"
+
+// Sentinelas que classificam os erros de domínio
+// Use errors.Is(err, domain.ErrNotFound) para descobrir a categoria de um erro,
+// independentemente de quantas camadas de wrapping existam
+var (
+	ErrNotFound           = errors.New("not found")
+	ErrConflict           = errors.New("conflict")
+	ErrValidation         = errors.New("validation failed")
+	ErrPreconditionFailed = errors.New("precondition failed")
+	ErrForbidden          = errors.New("forbidden")
+)
+
+// Error é um erro de domínio tipado
+// Carrega a categoria (Kind), um código estável para clientes da API e a causa original
+type Error struct {
+	Kind    error  // Uma das sentinelas acima
+	Code    string // Código estável (ex: "restaurant_not_found")
+	Message string // Mensagem legível
+	Err     error  // Causa original (opcional)
+}
+
+// NewNotFoundError cria um erro de recurso não encontrado
+func NewNotFoundError(code, message string) *Error {
+	return &Error{Kind: ErrNotFound, Code: code, Message: message}
+}
+
+// NewConflictError cria um erro de conflito com o estado atual do recurso
+func NewConflictError(code, message string) *Error {
+	return &Error{Kind: ErrConflict, Code: code, Message: message}
+}
+
+// NewValidationError cria um erro de validação de dados de entrada ou regra de negócio
+func NewValidationError(code, message string) *Error {
+	return &Error{Kind: ErrValidation, Code: code, Message: message}
+}
+
+// NewPreconditionFailedError cria um erro de pré-condição não satisfeita (ex: versão desatualizada)
+func NewPreconditionFailedError(code, message string) *Error {
+	return &Error{Kind: ErrPreconditionFailed, Code: code, Message: message}
+}
+
+// NewForbiddenError cria um erro de operação não permitida para o solicitante
+func NewForbiddenError(code, message string) *Error {
+	return &Error{Kind: ErrForbidden, Code: code, Message: message}
+}
+
+// Error implementa a interface error
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap expõe a categoria e a causa para errors.Is/errors.As
+func (e *Error) Unwrap() []error {
+	if e.Err != nil {
+		return []error{e.Kind, e.Err}
+	}
+	return []error{e.Kind}
+}
+
+// Is considera iguais dois *Error com a mesma categoria e o mesmo código
+// Permite comparar com os erros pré-definidos mesmo após Wrap
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+	return e.Kind == t.Kind && e.Code == t.Code
+}
+
+// Wrap retorna uma cópia do erro com a causa informada
+func (e *Error) Wrap(err error) *Error {
+	wrapped := *e
+	wrapped.Err = err
+	return &wrapped
+}
+
+// Erros pré-definidos do domínio de restaurantes
+var (
+	ErrRestaurantNotFound   = NewNotFoundError("restaurant_not_found", "restaurant not found")
+	ErrSlugAlreadyExists    = NewConflictError("slug_already_exists", "slug already exists")
+	ErrAddressNotFound      = NewNotFoundError("address_not_found", "restaurant has no address")
+	ErrVersionMismatch      = NewPreconditionFailedError("version_mismatch", "restaurant was modified by another request")
+	ErrSpecialHoursNotFound = NewNotFoundError("special_hours_not_found", "no special hours for this date")
+	ErrStatusChanged        = NewConflictError("status_changed", "restaurant status was changed by another request")
+	ErrFilterTooBroad       = NewValidationError("filter_too_broad", "too many restaurants to evaluate open_now/delivers_to; narrow the filters")
+)
+
+// Erros pré-definidos da taxonomia de categorias
+var (
+	ErrCategoryNotFound          = NewNotFoundError("category_not_found", "category not found")
+	ErrCategorySlugAlreadyExists = NewConflictError("category_slug_already_exists", "category slug already exists")
+	ErrCategoryInUse             = NewConflictError("category_in_use", "category still has restaurants")
+)
+
+// Erros pré-definidos do cardápio
+var (
+	ErrMenuSectionNotFound     = NewNotFoundError("menu_section_not_found", "menu section not found")
+	ErrMenuItemNotFound        = NewNotFoundError("menu_item_not_found", "menu item not found")
+	ErrMenuOptionGroupNotFound = NewNotFoundError("menu_option_group_not_found", "menu option group not found")
+	ErrMenuOptionNotFound      = NewNotFoundError("menu_option_not_found", "menu option not found")
+)
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestError_WrapKeepsKindAndCause(t *testing.T) {
+	// Input
+	cause := errors.New("no rows in result set")
+
+	// Output
+	err := fmt.Errorf("usecase: %w", ErrRestaurantNotFound.Wrap(cause))
+
+	// Assert
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, err, ErrRestaurantNotFound)
+	assert.ErrorIs(t, err, cause)
+	assert.NotErrorIs(t, err, ErrConflict)
+	assert.Equal(t, "usecase: restaurant not found: no rows in result set", err.Error())
+
+	var domainErr *Error
+	assert.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, "restaurant_not_found", domainErr.Code)
+}
+
+func TestError_DifferentCodesAreNotEqual(t *testing.T) {
+	// Input
+	err := NewValidationError("name_required", "name is required")
+
+	// Output
+	matches := errors.Is(err, NewValidationError("invalid_delivery_fee", "delivery fee cannot be negative"))
+
+	// Assert
+	assert.False(t, matches)
+	assert.ErrorIs(t, err, ErrValidation)
+}
@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// FacetValue é a quantidade de restaurantes para um valor de filtro
+// Value é o valor aceito pelo filtro correspondente; Label, quando presente, é o nome exibido
+type FacetValue struct {
+	Value string `json:"value"`
+	Label string `json:"label,omitempty"`
+	Count int64  `json:"count"`
+}
+
+// RestaurantFacets agrega as contagens usadas para montar os filtros da descoberta
+// Cada faceta ignora o próprio filtro (categoria e cidade), para que o usuário veja as
+// alternativas disponíveis; os demais filtros aplicados valem para todas
+// Valores sem resultados nunca aparecem
+type RestaurantFacets struct {
+	Total          int64        `json:"total"`
+	Categories     []FacetValue `json:"categories"`
+	Cities         []FacetValue `json:"cities"`
+	PaymentMethods []FacetValue `json:"payment_methods"`
+	OpenNow        int64        `json:"open_now"`
+}
+
+// ComputeFacets agrega as facetas em memória, quando há filtros que o banco não avalia
+// restaurants deve atender a todos os filtros exceto Category, City e OpenNow
+func ComputeFacets(restaurants []*Restaurant, filter RestaurantFilter, now time.Time) RestaurantFacets {
+	var facets RestaurantFacets
+	categories := newFacetCounter()
+	cities := newFacetCounter()
+	methods := newFacetCounter()
+
+	for _, restaurant := range restaurants {
+		inCategory := filter.Category == "" || restaurant.HasCategory(filter.Category)
+		inCity := filter.City == "" || (restaurant.Address != nil && strings.EqualFold(restaurant.Address.City, filter.City))
+		isOpen := restaurant.CalculateIsOpen(now)
+		inOpenNow := !filter.OpenNow || isOpen
+
+		if inCity && inOpenNow {
+			for _, category := range restaurant.Categories {
+				categories.add(category.Slug, category.Name)
+			}
+		}
+		if inCategory && inOpenNow && restaurant.Address != nil {
+			cities.add(restaurant.Address.City, "")
+		}
+		if !inCategory || !inCity {
+			continue
+		}
+		if isOpen {
+			facets.OpenNow++
+		}
+		if !inOpenNow {
+			continue
+		}
+		facets.Total++
+		for _, method := range restaurant.PaymentMethods {
+			methods.add(method.Method, "")
+		}
+	}
+
+	facets.Categories = categories.values()
+	facets.Cities = cities.values()
+	facets.PaymentMethods = methods.values()
+	return facets
+}
+
+// facetCounter conta valores sem diferenciar maiúsculas, mantendo a primeira grafia vista
+type facetCounter struct {
+	order  []string
+	facets map[string]FacetValue
+}
+
+func newFacetCounter() *facetCounter {
+	return &facetCounter{facets: map[string]FacetValue{}}
+}
+
+func (c *facetCounter) add(value, label string) {
+	key := strings.ToLower(value)
+	facet, ok := c.facets[key]
+	if !ok {
+		facet = FacetValue{Value: value, Label: label}
+		c.order = append(c.order, key)
+	}
+	facet.Count++
+	c.facets[key] = facet
+}
+
+// values retorna as contagens da maior para a menor, desempatando pelo valor
+func (c *facetCounter) values() []FacetValue {
+	values := make([]FacetValue, 0, len(c.order))
+	for _, key := range c.order {
+		values = append(values, c.facets[key])
+	}
+	sortFacetValues(values)
+	return values
+}
+
+// sortFacetValues ordena as contagens da maior para a menor, desempatando pelo valor
+func sortFacetValues(values []FacetValue) {
+	sort.SliceStable(values, func(i, j int) bool {
+		if values[i].Count != values[j].Count {
+			return values[i].Count > values[j].Count
+		}
+		return values[i].Value < values[j].Value
+	})
+}
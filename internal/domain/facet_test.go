@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeFacets_ExcludesOwnFilter(t *testing.T) {
+	// Input: segunda-feira 12:00 em São Paulo, filtrando cidade e aberto agora
+	now := time.Date(2024, time.March, 4, 15, 0, 0, 0, time.UTC)
+	lunch := []OpeningHour{{Weekday: int(time.Monday), OpensAt: 11 * 60, ClosesAt: 15 * 60}}
+	pizza := Category{Slug: "pizza", Name: "Pizza"}
+	japonesa := Category{Slug: "japonesa", Name: "Japonesa"}
+	restaurant := func(category Category, city string, hours []OpeningHour) *Restaurant {
+		return &Restaurant{
+			Category:       category.Name,
+			Categories:     []Category{category},
+			Status:         StatusOpen,
+			Timezone:       "America/Sao_Paulo",
+			OpeningHours:   hours,
+			Address:        &Address{City: city},
+			PaymentMethods: []PaymentMethod{{Method: "PIX"}},
+		}
+	}
+	restaurants := []*Restaurant{
+		restaurant(pizza, "Campinas", lunch),
+		restaurant(pizza, "Campinas", lunch),
+		restaurant(japonesa, "Campinas", nil),
+		restaurant(japonesa, "Santos", lunch),
+	}
+	filter := RestaurantFilter{City: "campinas", OpenNow: true}
+
+	// Output
+	facets := ComputeFacets(restaurants, filter, now)
+
+	// Assert
+	assert.Equal(t, int64(2), facets.Total)
+	assert.Equal(t, int64(2), facets.OpenNow)
+	assert.Equal(t, []FacetValue{{Value: "pizza", Label: "Pizza", Count: 2}}, facets.Categories)
+	assert.Equal(t, []FacetValue{{Value: "Campinas", Count: 2}, {Value: "Santos", Count: 1}}, facets.Cities)
+	assert.Equal(t, []FacetValue{{Value: "PIX", Count: 2}}, facets.PaymentMethods)
+}
@@ -0,0 +1,116 @@
+package domain
+
+import "math"
+
+const (
+	// EarthRadiusMeters é o raio médio da Terra usado no cálculo de haversine
+	EarthRadiusMeters = 6371000.0
+
+	// DefaultNearbyRadiusKm é o raio usado quando a busca não informa radius_km
+	DefaultNearbyRadiusKm = 5.0
+
+	// MaxNearbyRadiusKm limita o raio da busca por proximidade
+	MaxNearbyRadiusKm = 50.0
+)
+
+// GeoPoint representa uma coordenada geográfica
+type GeoPoint struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// Validate verifica se latitude e longitude estão nas faixas válidas (rejeita NaN)
+func (p GeoPoint) Validate() error {
+	if !(p.Lat >= -90 && p.Lat <= 90) {
+		return NewValidationError("invalid_lat", "lat must be between -90 and 90")
+	}
+	if !(p.Lng >= -180 && p.Lng <= 180) {
+		return NewValidationError("invalid_lng", "lng must be between -180 and 180")
+	}
+	return nil
+}
+
+// NearbySearch representa uma busca de restaurantes ao redor de um ponto
+type NearbySearch struct {
+	Lat      float64
+	Lng      float64
+	RadiusKm float64 // 0 = DefaultNearbyRadiusKm
+	OpenNow  bool    // Avaliado no fuso de cada restaurante
+}
+
+// Validate valida coordenadas e raio, aplicando o raio padrão quando ausente
+// As comparações são escritas de forma a rejeitar NaN
+func (s *NearbySearch) Validate() error {
+	if err := (GeoPoint{Lat: s.Lat, Lng: s.Lng}).Validate(); err != nil {
+		return err
+	}
+
+	if s.RadiusKm == 0 {
+		s.RadiusKm = DefaultNearbyRadiusKm
+	}
+	if !(s.RadiusKm > 0 && s.RadiusKm <= MaxNearbyRadiusKm) {
+		return NewValidationError("invalid_radius", "radius_km must be greater than 0 and at most 50")
+	}
+
+	return nil
+}
+
+// RadiusMeters retorna o raio da busca em metros
+func (s NearbySearch) RadiusMeters() float64 {
+	return s.RadiusKm * 1000
+}
+
+// BoundingBox é o retângulo lat/lng que contém o círculo da busca
+// Serve de pré-filtro indexável antes do cálculo exato da distância
+type BoundingBox struct {
+	MinLat float64
+	MaxLat float64
+	MinLng float64
+	MaxLng float64
+}
+
+// BoundingBoxAround calcula o retângulo que contém todos os pontos a até radiusMeters do centro
+// Perto dos polos ou do antimeridiano a longitude passa a cobrir toda a faixa [-180, 180]
+func BoundingBoxAround(lat, lng, radiusMeters float64) BoundingBox {
+	deltaLat := degrees(radiusMeters / EarthRadiusMeters)
+	box := BoundingBox{
+		MinLat: math.Max(lat-deltaLat, -90),
+		MaxLat: math.Min(lat+deltaLat, 90),
+		MinLng: -180,
+		MaxLng: 180,
+	}
+	if box.MinLat == -90 || box.MaxLat == 90 {
+		return box
+	}
+
+	// Maior diferença de longitude de um ponto do círculo: asin(sin(d) / cos(lat))
+	sinDelta := math.Sin(radiusMeters/EarthRadiusMeters) / math.Cos(radians(lat))
+	if sinDelta >= 1 {
+		return box
+	}
+	deltaLng := degrees(math.Asin(sinDelta))
+	if lng-deltaLng < -180 || lng+deltaLng > 180 {
+		return box
+	}
+	box.MinLng = lng - deltaLng
+	box.MaxLng = lng + deltaLng
+	return box
+}
+
+// HaversineMeters calcula a distância em metros entre dois pontos (grande círculo)
+// Mesma fórmula usada na query ListNearbyRestaurants
+func HaversineMeters(lat1, lng1, lat2, lng2 float64) float64 {
+	dLat := radians(lat2 - lat1)
+	dLng := radians(lng2 - lng1)
+	a := math.Pow(math.Sin(dLat/2), 2) +
+		math.Cos(radians(lat1))*math.Cos(radians(lat2))*math.Pow(math.Sin(dLng/2), 2)
+	return 2 * EarthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func degrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHaversineMeters(t *testing.T) {
+	// Input: Praça da Sé (SP) e Praça XV (RJ), ~360 km em linha reta
+	se := [2]float64{-23.5503, -46.6339}
+	praca15 := [2]float64{-22.9027, -43.1737}
+
+	// Output
+	distance := HaversineMeters(se[0], se[1], praca15[0], praca15[1])
+	same := HaversineMeters(se[0], se[1], se[0], se[1])
+
+	// Assert
+	assert.InDelta(t, 360000, distance, 5000)
+	assert.Zero(t, same)
+}
+
+func TestBoundingBoxAround_ContainsCircle(t *testing.T) {
+	// Input
+	lat, lng, radius := -23.5503, -46.6339, 5000.0
+
+	// Output
+	box := BoundingBoxAround(lat, lng, radius)
+
+	// Assert: pontos na borda do círculo (N, S, L, O) ficam dentro do retângulo
+	assert.InDelta(t, radius, HaversineMeters(lat, lng, box.MaxLat, lng), 1)
+	assert.InDelta(t, radius, HaversineMeters(lat, lng, box.MinLat, lng), 1)
+	assert.GreaterOrEqual(t, HaversineMeters(lat, lng, lat, box.MaxLng), radius)
+	assert.GreaterOrEqual(t, HaversineMeters(lat, lng, lat, box.MinLng), radius)
+	assert.Less(t, box.MaxLng-box.MinLng, 1.0)
+}
+
+func TestBoundingBoxAround_PolesAndAntimeridian(t *testing.T) {
+	// Input
+	nearPole := BoundingBoxAround(89.99, 10, 5000)
+	nearAntimeridian := BoundingBoxAround(0, 179.99, 5000)
+
+	// Assert
+	assert.Equal(t, 90.0, nearPole.MaxLat)
+	assert.Equal(t, -180.0, nearPole.MinLng)
+	assert.Equal(t, 180.0, nearPole.MaxLng)
+	assert.Equal(t, -180.0, nearAntimeridian.MinLng)
+	assert.Equal(t, 180.0, nearAntimeridian.MaxLng)
+}
+
+func TestNearbySearch_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		search NearbySearch
+		code   string
+	}{
+		{name: "valid", search: NearbySearch{Lat: -23.55, Lng: -46.63, RadiusKm: 3}},
+		{name: "default radius", search: NearbySearch{Lat: -23.55, Lng: -46.63}},
+		{name: "invalid lat", search: NearbySearch{Lat: -91, Lng: -46.63}, code: "invalid_lat"},
+		{name: "nan lat", search: NearbySearch{Lat: math.NaN(), Lng: -46.63}, code: "invalid_lat"},
+		{name: "invalid lng", search: NearbySearch{Lat: -23.55, Lng: 181}, code: "invalid_lng"},
+		{name: "negative radius", search: NearbySearch{Lat: -23.55, Lng: -46.63, RadiusKm: -1}, code: "invalid_radius"},
+		{name: "radius too large", search: NearbySearch{Lat: -23.55, Lng: -46.63, RadiusKm: 51}, code: "invalid_radius"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Output
+			err := tt.search.Validate()
+
+			// Assert
+			if tt.code == "" {
+				assert.NoError(t, err)
+				assert.Greater(t, tt.search.RadiusKm, 0.0)
+				return
+			}
+			var domainErr *Error
+			assert.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, tt.code, domainErr.Code)
+		})
+	}
+}
+
+func TestRestaurant_DistanceJSONKey(t *testing.T) {
+	// Input
+	distance := 120.5
+	near := Restaurant{Name: "Perto", DistanceMeters: &distance}
+	listed := Restaurant{Name: "Listado"}
+
+	// Output
+	nearBody, err := json.Marshal(near)
+	require.NoError(t, err)
+	listedBody, err := json.Marshal(listed)
+	require.NoError(t, err)
+
+	// Assert: distance_m só aparece na busca por proximidade
+	assert.Contains(t, string(nearBody), `"distance_m":120.5`)
+	assert.NotContains(t, string(nearBody), "DistanceMeters")
+	assert.NotContains(t, string(listedBody), "distance_m")
+}
@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Limites do cardápio (tamanhos das colunas em menu_sections/menu_items)
+const (
+	MaxMenuSectionNameLength = 100
+	MaxMenuItemNameLength    = 150
+)
+
+// MenuSection é uma seção do cardápio (ex: "Pizzas", "Bebidas")
+type MenuSection struct {
+	ID           uuid.UUID     `json:"id"`
+	RestaurantID uuid.UUID     `json:"restaurant_id"`
+	Name         string        `json:"name"`
+	Description  string        `json:"description,omitempty"`
+	Position     int           `json:"position"` // Ordem de exibição (crescente)
+	Active       bool          `json:"active"`
+	Availability []OpeningHour `json:"availability"` // Janelas semanais de venda; vazio = sempre disponível
+	Available    bool          `json:"available"`    // Calculado na árvore do cardápio para o instante avaliado
+	Items        []MenuItem    `json:"items"`        // Preenchido apenas na árvore do cardápio
+	CreatedAt    time.Time     `json:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+}
+
+// MenuItem é um item vendido pelo restaurante
+type MenuItem struct {
+	ID           uuid.UUID         `json:"id"`
+	RestaurantID uuid.UUID         `json:"restaurant_id"`
+	SectionID    uuid.UUID         `json:"section_id"`
+	Name         string            `json:"name"`
+	Description  string            `json:"description,omitempty"`
+	Price        int64             `json:"price"`               // Em centavos
+	ImageURL     string            `json:"image_url,omitempty"` // Não obrigatório
+	Position     int               `json:"position"`            // Ordem dentro da seção (crescente)
+	Active       bool              `json:"active"`
+	Availability []OpeningHour     `json:"availability"`  // Janelas semanais de venda; vazio = sempre disponível
+	Available    bool              `json:"available"`     // Calculado na árvore do cardápio (inclui as janelas da seção)
+	OptionGroups []MenuOptionGroup `json:"option_groups"` // Preenchido na árvore do cardápio
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+}
+
+// Menu é a árvore ordenada do cardápio de um restaurante
+type Menu struct {
+	RestaurantID uuid.UUID     `json:"restaurant_id"`
+	EvaluatedAt  time.Time     `json:"evaluated_at"` // Instante usado em available, no fuso do restaurante
+	Sections     []MenuSection `json:"sections"`
+}
+
+// Validate valida os dados da seção
+func (s *MenuSection) Validate() error {
+	s.Name = strings.TrimSpace(s.Name)
+	if s.Name == "" {
+		return NewValidationError("section_name_required", "section name is required")
+	}
+	if utf8.RuneCountInString(s.Name) > MaxMenuSectionNameLength {
+		return NewValidationError("invalid_section_name", fmt.Sprintf("section name must have at most %d characters", MaxMenuSectionNameLength))
+	}
+	if s.Position < 0 {
+		return NewValidationError("invalid_position", "position cannot be negative")
+	}
+	return nil
+}
+
+// Validate valida os dados do item
+func (i *MenuItem) Validate() error {
+	i.Name = strings.TrimSpace(i.Name)
+	if i.Name == "" {
+		return NewValidationError("item_name_required", "item name is required")
+	}
+	if utf8.RuneCountInString(i.Name) > MaxMenuItemNameLength {
+		return NewValidationError("invalid_item_name", fmt.Sprintf("item name must have at most %d characters", MaxMenuItemNameLength))
+	}
+	if i.Price < 0 {
+		return NewValidationError("invalid_price", "price cannot be negative")
+	}
+	if i.Position < 0 {
+		return NewValidationError("invalid_position", "position cannot be negative")
+	}
+	return nil
+}
+
+// BuildMenu monta a árvore do cardápio a partir das seções, itens e grupos de opções já ordenados
+// Seções, itens e opções inativos (e os itens de seções inativas) ficam de fora
+func BuildMenu(restaurantID uuid.UUID, sections []MenuSection, items []MenuItem, groups []MenuOptionGroup) *Menu {
+	menu := &Menu{RestaurantID: restaurantID, Sections: make([]MenuSection, 0, len(sections))}
+
+	byItem := make(map[uuid.UUID][]MenuOptionGroup, len(items))
+	for _, group := range groups {
+		options := make([]MenuOption, 0, len(group.Options))
+		for _, option := range group.Options {
+			if option.Active {
+				options = append(options, option)
+			}
+		}
+		group.Options = options
+		byItem[group.ItemID] = append(byItem[group.ItemID], group)
+	}
+
+	bySection := make(map[uuid.UUID][]MenuItem, len(sections))
+	for _, item := range items {
+		if !item.Active {
+			continue
+		}
+		item.OptionGroups = byItem[item.ID]
+		if item.OptionGroups == nil {
+			item.OptionGroups = []MenuOptionGroup{}
+		}
+		bySection[item.SectionID] = append(bySection[item.SectionID], item)
+	}
+
+	for _, section := range sections {
+		if !section.Active {
+			continue
+		}
+		section.Items = bySection[section.ID]
+		if section.Items == nil {
+			section.Items = []MenuItem{}
+		}
+		menu.Sections = append(menu.Sections, section)
+	}
+	return menu
+}
+
+// RefreshAvailability preenche Available de seções e itens para o horário local informado
+// (já convertido para o fuso do restaurante). Um item só está disponível dentro das
+// próprias janelas e das janelas da sua seção; janelas com closes_at < opens_at seguem até o dia seguinte
+func (m *Menu) RefreshAvailability(local time.Time) {
+	m.EvaluatedAt = local
+	minute := MinuteOfWeek(local)
+
+	for s := range m.Sections {
+		section := &m.Sections[s]
+		section.Available = availableAt(section.Availability, minute)
+		for i := range section.Items {
+			item := &section.Items[i]
+			item.Available = section.Available && availableAt(item.Availability, minute)
+		}
+	}
+}
+
+// availableAt informa se o minuto da semana está em alguma janela; sem janelas, está sempre disponível
+func availableAt(windows []OpeningHour, minute int) bool {
+	if len(windows) == 0 {
+		return true
+	}
+	return NewWeeklySchedule(windows).Contains(minute)
+}
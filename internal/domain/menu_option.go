@@ -0,0 +1,201 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Limites dos grupos de opções
+const (
+	MaxMenuOptionGroupsPerItem = 10
+	MaxMenuOptionsPerGroup     = 30
+	MaxMenuOptionNameLength    = 100
+	MaxItemQuantity            = 99
+)
+
+// MenuOptionGroup é um grupo de opções de um item (ex: "Tamanho", "Adicionais")
+// Grupo opcional pode ficar sem escolha; se escolhido (ou se obrigatório), respeita min/max
+type MenuOptionGroup struct {
+	ID            uuid.UUID    `json:"id"`
+	RestaurantID  uuid.UUID    `json:"restaurant_id"`
+	ItemID        uuid.UUID    `json:"item_id"`
+	Name          string       `json:"name"`
+	MinSelections int          `json:"min_selections"`
+	MaxSelections int          `json:"max_selections"`
+	Required      bool         `json:"required"`
+	Position      int          `json:"position"` // Ordem dentro do item (crescente)
+	Options       []MenuOption `json:"options"`
+	CreatedAt     time.Time    `json:"created_at"`
+	UpdatedAt     time.Time    `json:"updated_at"`
+}
+
+// MenuOption é uma opção de um grupo (ex: "Grande", "Bacon extra")
+type MenuOption struct {
+	ID           uuid.UUID `json:"id"`
+	RestaurantID uuid.UUID `json:"restaurant_id"`
+	GroupID      uuid.UUID `json:"group_id"`
+	Name         string    `json:"name"`
+	PriceDelta   int64     `json:"price_delta"` // Em centavos, somado ao preço do item; pode ser negativo
+	Position     int       `json:"position"`    // Ordem dentro do grupo (crescente)
+	Active       bool      `json:"active"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// ItemSelection é a escolha do cliente para uma linha do pedido
+type ItemSelection struct {
+	Quantity  int
+	OptionIDs []uuid.UUID
+}
+
+// Validate valida os dados da opção
+func (o *MenuOption) Validate() error {
+	o.Name = strings.TrimSpace(o.Name)
+	if o.Name == "" {
+		return NewValidationError("option_name_required", "option name is required")
+	}
+	if utf8.RuneCountInString(o.Name) > MaxMenuOptionNameLength {
+		return NewValidationError("invalid_option_name", fmt.Sprintf("option name must have at most %d characters", MaxMenuOptionNameLength))
+	}
+	if o.Position < 0 {
+		return NewValidationError("invalid_position", "position cannot be negative")
+	}
+	return nil
+}
+
+// Validate valida o grupo, as suas regras de seleção e as opções
+func (g *MenuOptionGroup) Validate() error {
+	g.Name = strings.TrimSpace(g.Name)
+	if g.Name == "" {
+		return NewValidationError("option_group_name_required", "option group name is required")
+	}
+	if utf8.RuneCountInString(g.Name) > MaxMenuOptionNameLength {
+		return NewValidationError("invalid_option_group_name", fmt.Sprintf("option group name must have at most %d characters", MaxMenuOptionNameLength))
+	}
+	if g.Position < 0 {
+		return NewValidationError("invalid_position", "position cannot be negative")
+	}
+	if len(g.Options) == 0 {
+		return NewValidationError("options_required", fmt.Sprintf("option group %q must have at least one option", g.Name))
+	}
+	if len(g.Options) > MaxMenuOptionsPerGroup {
+		return NewValidationError("too_many_options", fmt.Sprintf("option group %q must have at most %d options", g.Name, MaxMenuOptionsPerGroup))
+	}
+	if g.MinSelections < 0 || g.MaxSelections < 1 || g.MinSelections > g.MaxSelections {
+		return NewValidationError("invalid_selection_limits", fmt.Sprintf("option group %q must have 0 <= min_selections <= max_selections and max_selections >= 1", g.Name))
+	}
+	if g.minSelections() > len(g.Options) {
+		return NewValidationError("invalid_selection_limits", fmt.Sprintf("option group %q requires more selections than it has options", g.Name))
+	}
+	if g.minSelections() > g.activeOptions() {
+		return NewValidationError("not_enough_active_options", fmt.Sprintf("option group %q requires more selections than it has active options", g.Name))
+	}
+
+	for i := range g.Options {
+		if err := g.Options[i].Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// minSelections retorna o mínimo efetivo: grupo obrigatório exige ao menos uma escolha
+func (g *MenuOptionGroup) minSelections() int {
+	if g.Required && g.MinSelections == 0 {
+		return 1
+	}
+	return g.MinSelections
+}
+
+// activeOptions conta as opções que o cliente pode escolher
+func (g *MenuOptionGroup) activeOptions() int {
+	count := 0
+	for i := range g.Options {
+		if g.Options[i].Active {
+			count++
+		}
+	}
+	return count
+}
+
+// ValidateMenuOptionGroups valida o conjunto de grupos de um item
+func ValidateMenuOptionGroups(groups []MenuOptionGroup) error {
+	if len(groups) > MaxMenuOptionGroupsPerItem {
+		return NewValidationError("too_many_option_groups", fmt.Sprintf("at most %d option groups are allowed per item", MaxMenuOptionGroupsPerItem))
+	}
+
+	for i := range groups {
+		if err := groups[i].Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PriceSelection valida a escolha do cliente contra as regras dos grupos do item
+// e retorna o preço da linha em centavos: (preço do item + deltas das opções) * quantidade
+func (i *MenuItem) PriceSelection(selection ItemSelection) (int64, error) {
+	if !i.Active {
+		return 0, NewValidationError("item_unavailable", fmt.Sprintf("item %q is unavailable", i.Name))
+	}
+	if selection.Quantity < 1 || selection.Quantity > MaxItemQuantity {
+		return 0, NewValidationError("invalid_quantity", fmt.Sprintf("quantity must be between 1 and %d", MaxItemQuantity))
+	}
+
+	type optionRef struct {
+		group  int
+		option *MenuOption
+	}
+	options := make(map[uuid.UUID]optionRef)
+	for g := range i.OptionGroups {
+		for o := range i.OptionGroups[g].Options {
+			option := &i.OptionGroups[g].Options[o]
+			options[option.ID] = optionRef{group: g, option: option}
+		}
+	}
+
+	unitPrice := i.Price
+	counts := make([]int, len(i.OptionGroups))
+	seen := make(map[uuid.UUID]bool, len(selection.OptionIDs))
+	for _, id := range selection.OptionIDs {
+		ref, ok := options[id]
+		if !ok {
+			return 0, NewValidationError("unknown_option", fmt.Sprintf("option %s does not belong to item %q", id, i.Name))
+		}
+		if seen[id] {
+			return 0, NewValidationError("duplicate_option", fmt.Sprintf("option %q was selected more than once", ref.option.Name))
+		}
+		if !ref.option.Active {
+			return 0, NewValidationError("option_unavailable", fmt.Sprintf("option %q is unavailable", ref.option.Name))
+		}
+		seen[id] = true
+		counts[ref.group]++
+		unitPrice += ref.option.PriceDelta
+	}
+
+	for g := range i.OptionGroups {
+		group := &i.OptionGroups[g]
+		count := counts[g]
+		if count == 0 && !group.Required {
+			continue
+		}
+		if count == 0 {
+			return 0, NewValidationError("option_group_required", fmt.Sprintf("option group %q requires a selection", group.Name))
+		}
+		if count < group.minSelections() {
+			return 0, NewValidationError("too_few_options", fmt.Sprintf("option group %q requires at least %d selections", group.Name, group.minSelections()))
+		}
+		if count > group.MaxSelections {
+			return 0, NewValidationError("too_many_options", fmt.Sprintf("option group %q allows at most %d selections", group.Name, group.MaxSelections))
+		}
+	}
+
+	if unitPrice < 0 {
+		return 0, NewValidationError("invalid_price", "item price with options cannot be negative")
+	}
+	return unitPrice * int64(selection.Quantity), nil
+}
@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMenuOptionGroup_Validate(t *testing.T) {
+	option := MenuOption{Name: "Bacon extra", PriceDelta: 500, Active: true}
+	inactive := MenuOption{Name: "Cheddar", PriceDelta: 300}
+	tests := []struct {
+		name  string
+		group MenuOptionGroup
+		code  string
+	}{
+		{name: "valid", group: MenuOptionGroup{Name: "Adicionais", MaxSelections: 3, Options: []MenuOption{option}}},
+		{name: "required without min", group: MenuOptionGroup{Name: "Tamanho", MaxSelections: 1, Required: true, Options: []MenuOption{option}}},
+		{name: "missing name", group: MenuOptionGroup{Name: " ", MaxSelections: 1, Options: []MenuOption{option}}, code: "option_group_name_required"},
+		{name: "no options", group: MenuOptionGroup{Name: "Adicionais", MaxSelections: 1}, code: "options_required"},
+		{name: "zero max", group: MenuOptionGroup{Name: "Adicionais", Options: []MenuOption{option}}, code: "invalid_selection_limits"},
+		{name: "min above max", group: MenuOptionGroup{Name: "Adicionais", MinSelections: 2, MaxSelections: 1, Options: []MenuOption{option}}, code: "invalid_selection_limits"},
+		{name: "min above options", group: MenuOptionGroup{Name: "Adicionais", MinSelections: 2, MaxSelections: 3, Options: []MenuOption{option}}, code: "invalid_selection_limits"},
+		{name: "optional with inactive options", group: MenuOptionGroup{Name: "Adicionais", MaxSelections: 1, Options: []MenuOption{inactive}}},
+		{name: "required with inactive options", group: MenuOptionGroup{Name: "Tamanho", MaxSelections: 1, Required: true, Options: []MenuOption{inactive}}, code: "not_enough_active_options"},
+		{name: "min above active options", group: MenuOptionGroup{Name: "Adicionais", MinSelections: 2, MaxSelections: 2, Options: []MenuOption{option, inactive}}, code: "not_enough_active_options"},
+		{name: "invalid option", group: MenuOptionGroup{Name: "Adicionais", MaxSelections: 1, Options: []MenuOption{{Name: ""}}}, code: "option_name_required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Output
+			err := tt.group.Validate()
+
+			// Assert
+			if tt.code == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var domainErr *Error
+			assert.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, tt.code, domainErr.Code)
+		})
+	}
+}
+
+func TestMenuItem_PriceSelection(t *testing.T) {
+	// Input: pizza com tamanho obrigatório e até 2 adicionais
+	broto := MenuOption{ID: uuid.New(), Name: "Broto", PriceDelta: -1000, Active: true}
+	grande := MenuOption{ID: uuid.New(), Name: "Grande", PriceDelta: 1500, Active: true}
+	bacon := MenuOption{ID: uuid.New(), Name: "Bacon", PriceDelta: 500, Active: true}
+	catupiry := MenuOption{ID: uuid.New(), Name: "Catupiry", PriceDelta: 700, Active: true}
+	cheddar := MenuOption{ID: uuid.New(), Name: "Cheddar", PriceDelta: 600, Active: true}
+	esgotado := MenuOption{ID: uuid.New(), Name: "Esgotado", PriceDelta: 100, Active: false}
+	item := MenuItem{
+		Name:   "Margherita",
+		Price:  4000,
+		Active: true,
+		OptionGroups: []MenuOptionGroup{
+			{Name: "Tamanho", MinSelections: 1, MaxSelections: 1, Required: true, Options: []MenuOption{broto, grande}},
+			{Name: "Adicionais", MaxSelections: 2, Options: []MenuOption{bacon, catupiry, cheddar, esgotado}},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		selection ItemSelection
+		price     int64
+		code      string
+	}{
+		{name: "required only", selection: ItemSelection{Quantity: 1, OptionIDs: []uuid.UUID{grande.ID}}, price: 5500},
+		{name: "with extras and quantity", selection: ItemSelection{Quantity: 2, OptionIDs: []uuid.UUID{broto.ID, bacon.ID, catupiry.ID}}, price: 8400},
+		{name: "missing required group", selection: ItemSelection{Quantity: 1, OptionIDs: []uuid.UUID{bacon.ID}}, code: "option_group_required"},
+		{name: "too many in group", selection: ItemSelection{Quantity: 1, OptionIDs: []uuid.UUID{broto.ID, grande.ID}}, code: "too_many_options"},
+		{name: "too many extras", selection: ItemSelection{Quantity: 1, OptionIDs: []uuid.UUID{grande.ID, bacon.ID, catupiry.ID, cheddar.ID}}, code: "too_many_options"},
+		{name: "duplicate option", selection: ItemSelection{Quantity: 1, OptionIDs: []uuid.UUID{grande.ID, bacon.ID, bacon.ID}}, code: "duplicate_option"},
+		{name: "unknown option", selection: ItemSelection{Quantity: 1, OptionIDs: []uuid.UUID{grande.ID, uuid.New()}}, code: "unknown_option"},
+		{name: "inactive option", selection: ItemSelection{Quantity: 1, OptionIDs: []uuid.UUID{grande.ID, esgotado.ID}}, code: "option_unavailable"},
+		{name: "zero quantity", selection: ItemSelection{Quantity: 0, OptionIDs: []uuid.UUID{grande.ID}}, code: "invalid_quantity"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Output
+			price, err := item.PriceSelection(tt.selection)
+
+			// Assert
+			if tt.code == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.price, price)
+				return
+			}
+			var domainErr *Error
+			assert.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, tt.code, domainErr.Code)
+		})
+	}
+}
+
+func TestMenuItem_PriceSelection_OptionalGroupMinimum(t *testing.T) {
+	// Input: açaí com coberturas opcionais, mas, se escolhidas, de 2 a 3
+	toppings := []MenuOption{
+		{ID: uuid.New(), Name: "Granola", Active: true},
+		{ID: uuid.New(), Name: "Banana", Active: true},
+		{ID: uuid.New(), Name: "Leite em pó", PriceDelta: 200, Active: true},
+	}
+	item := MenuItem{
+		Name:         "Açaí 500ml",
+		Price:        2200,
+		Active:       true,
+		OptionGroups: []MenuOptionGroup{{Name: "Coberturas", MinSelections: 2, MaxSelections: 3, Options: toppings}},
+	}
+
+	// Output
+	plain, plainErr := item.PriceSelection(ItemSelection{Quantity: 1})
+	_, fewErr := item.PriceSelection(ItemSelection{Quantity: 1, OptionIDs: []uuid.UUID{toppings[0].ID}})
+	full, fullErr := item.PriceSelection(ItemSelection{Quantity: 1, OptionIDs: []uuid.UUID{toppings[0].ID, toppings[1].ID, toppings[2].ID}})
+
+	// Assert
+	assert.NoError(t, plainErr)
+	assert.Equal(t, int64(2200), plain)
+	var domainErr *Error
+	assert.ErrorAs(t, fewErr, &domainErr)
+	assert.Equal(t, "too_few_options", domainErr.Code)
+	assert.NoError(t, fullErr)
+	assert.Equal(t, int64(2400), full)
+}
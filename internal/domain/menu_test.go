@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMenuItem_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		item MenuItem
+		code string
+	}{
+		{name: "valid", item: MenuItem{Name: " Margherita ", Price: 4590, Position: 1}},
+		{name: "free item", item: MenuItem{Name: "Margherita", Price: 0}},
+		{name: "missing name", item: MenuItem{Name: " ", Price: 4590}, code: "item_name_required"},
+		{name: "name too long", item: MenuItem{Name: strings.Repeat("a", 151), Price: 4590}, code: "invalid_item_name"},
+		{name: "negative price", item: MenuItem{Name: "Margherita", Price: -1}, code: "invalid_price"},
+		{name: "negative position", item: MenuItem{Name: "Margherita", Position: -1}, code: "invalid_position"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Output
+			err := tt.item.Validate()
+
+			// Assert
+			if tt.code == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, "Margherita", tt.item.Name)
+				return
+			}
+			var domainErr *Error
+			assert.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, tt.code, domainErr.Code)
+		})
+	}
+}
+
+func TestBuildMenu(t *testing.T) {
+	// Input
+	restaurantID := uuid.New()
+	pizzas := MenuSection{ID: uuid.New(), Name: "Pizzas", Position: 0, Active: true}
+	drinks := MenuSection{ID: uuid.New(), Name: "Bebidas", Position: 1, Active: true}
+	hidden := MenuSection{ID: uuid.New(), Name: "Sazonal", Position: 2, Active: false}
+	margherita := MenuItem{ID: uuid.New(), SectionID: pizzas.ID, Name: "Margherita", Position: 0, Active: true}
+	items := []MenuItem{
+		margherita,
+		{ID: uuid.New(), SectionID: pizzas.ID, Name: "Calabresa", Position: 1, Active: false},
+		{ID: uuid.New(), SectionID: pizzas.ID, Name: "Portuguesa", Position: 2, Active: true},
+		{ID: uuid.New(), SectionID: hidden.ID, Name: "Panetone", Position: 0, Active: true},
+	}
+
+	groups := []MenuOptionGroup{{
+		ID:     uuid.New(),
+		ItemID: margherita.ID,
+		Name:   "Tamanho",
+		Options: []MenuOption{
+			{ID: uuid.New(), Name: "Média", Active: true},
+			{ID: uuid.New(), Name: "Broto", Active: false},
+		},
+	}}
+
+	// Output
+	menu := BuildMenu(restaurantID, []MenuSection{pizzas, drinks, hidden}, items, groups)
+
+	// Assert
+	assert.Equal(t, restaurantID, menu.RestaurantID)
+	assert.Len(t, menu.Sections, 2)
+	assert.Equal(t, "Pizzas", menu.Sections[0].Name)
+	assert.Len(t, menu.Sections[0].Items, 2)
+	assert.Equal(t, "Margherita", menu.Sections[0].Items[0].Name)
+	assert.Equal(t, "Portuguesa", menu.Sections[0].Items[1].Name)
+	assert.Len(t, menu.Sections[0].Items[0].OptionGroups, 1)
+	assert.Len(t, menu.Sections[0].Items[0].OptionGroups[0].Options, 1)
+	assert.Equal(t, "Média", menu.Sections[0].Items[0].OptionGroups[0].Options[0].Name)
+	assert.NotNil(t, menu.Sections[0].Items[1].OptionGroups)
+	assert.Empty(t, menu.Sections[0].Items[1].OptionGroups)
+	assert.Equal(t, "Bebidas", menu.Sections[1].Name)
+	assert.NotNil(t, menu.Sections[1].Items)
+	assert.Empty(t, menu.Sections[1].Items)
+}
+
+func TestMenu_RefreshAvailability(t *testing.T) {
+	// Input: PF só no almoço de segunda; seção noturna de sexta 22:00 até sábado 02:00
+	lunch := MenuItem{Name: "PF", Active: true, Availability: []OpeningHour{{Weekday: 1, OpensAt: 660, ClosesAt: 900}}}
+	always := MenuItem{Name: "Refrigerante", Active: true}
+	menu := Menu{Sections: []MenuSection{
+		{Name: "Pratos", Active: true, Items: []MenuItem{lunch, always}},
+		{Name: "Madrugada", Active: true, Availability: []OpeningHour{{Weekday: 5, OpensAt: 1320, ClosesAt: 120}}, Items: []MenuItem{always}},
+	}}
+
+	tests := []struct {
+		name      string
+		local     time.Time
+		lunch     bool
+		lateNight bool
+	}{
+		{name: "monday lunch", local: time.Date(2025, 6, 2, 12, 0, 0, 0, time.UTC), lunch: true},
+		{name: "monday closing minute", local: time.Date(2025, 6, 2, 15, 0, 0, 0, time.UTC)},
+		{name: "tuesday lunch", local: time.Date(2025, 6, 3, 12, 0, 0, 0, time.UTC)},
+		{name: "saturday after midnight", local: time.Date(2025, 6, 7, 1, 30, 0, 0, time.UTC), lateNight: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Output
+			menu.RefreshAvailability(tt.local)
+
+			// Assert
+			assert.Equal(t, tt.local, menu.EvaluatedAt)
+			assert.Equal(t, tt.lunch, menu.Sections[0].Items[0].Available)
+			assert.True(t, menu.Sections[0].Items[1].Available)
+			assert.Equal(t, tt.lateNight, menu.Sections[1].Available)
+			assert.Equal(t, tt.lateNight, menu.Sections[1].Items[0].Available)
+		})
+	}
+}
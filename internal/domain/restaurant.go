@@ -12,25 +12,35 @@ type Restaurant struct {
 	Name               string
 	Slug               string // "pizza-do-joao" (Unique)
 	Description        string
-	Status             string // "DRAFT", "OPEN", "CLOSED", "SUSPENDED"
-	Category           string // "Pizza", "Burgers"
-	Rating             int    // 0, 1, 2, 3, 4 ou 5
-	TotalReviews       int    // Default 0
-	IsOpen             bool   // Campo computado
-	DeliveryFee        int64  // unidades monetárias (centavos)
-	MinOrderValue      int64  // unidades monetárias (centavos)
-	PreparationTimeMin int    // em minutos
+	Status             string     // "DRAFT", "OPEN", "CLOSED", "SUSPENDED"
+	StatusReason       string     `json:",omitempty"` // Motivo da última suspensão/reativação
+	Category           string     // Nome da categoria principal ("Pizza")
+	Rating             int        // 0, 1, 2, 3, 4 ou 5
+	TotalReviews       int        // Default 0
+	IsOpen             bool       // Campo computado
+	NextOpensAt        *time.Time `json:"next_opens_at"`        // Campo computado: próxima abertura
+	NextClosesAt       *time.Time `json:"next_closes_at"`       // Campo computado: próximo fechamento
+	DistanceMeters     *float64   `json:"distance_m,omitempty"` // Campo computado: preenchido apenas na busca por proximidade
+	SearchRank         *float32   `json:",omitempty"`           // Campo computado: relevância na busca textual (q)
+	DeliveryFee        int64      // unidades monetárias (centavos)
+	MinOrderValue      int64      // unidades monetárias (centavos)
+	PreparationTimeMin int        // em minutos
 	SupportsPickup     bool
 	SupportsDelivery   bool
 	LogoURL            string // Não obrigatório
 	BannerURL          string // Não obrigatório
+	Version            int    // Controle de concorrência otimista (exposto como ETag)
+	Timezone           string `json:",omitempty"` // Fuso IANA explícito; vazio = inferido pela UF do endereço
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
 
 	// Relacionamentos (Carregados com o Aggregate)
 	Address        *Address
 	OpeningHours   []OpeningHour
+	Categories     []Category // A primeira é a principal (espelhada em Category)
 	PaymentMethods []PaymentMethod
+	SpecialHours   []SpecialHours // Próximas exceções por data
+	DeliveryAreas  []DeliveryArea
 }
 
 // Address representa o endereço de um restaurante
@@ -43,8 +53,8 @@ type Address struct {
 	City         string
 	State        string // char(2)
 	ZipCode      string
-	Lat          float64
-	Lng          float64
+	Lat          *float64 // Opcional no DRAFT, obrigatório para abrir (R3)
+	Lng          *float64 // Opcional no DRAFT, obrigatório para abrir (R3)
 }
 
 // OpeningHour representa um horário de funcionamento de um restaurante
@@ -78,38 +88,54 @@ const (
 	PaymentMethodDebitCard  = "DEBIT_CARD"
 )
 
+// IsPublished informa se o restaurante aparece para clientes (cardápio público)
+// Rascunhos ainda não foram abertos e suspensos foram retirados por um admin
+func (r *Restaurant) IsPublished() bool {
+	return r.Status == StatusOpen || r.Status == StatusClosed
+}
+
 // CalculateIsOpen calcula se o restaurante está aberto no momento atual
 // Retorna true apenas se: Status == OPEN E horário atual está dentro de um intervalo válido
+// O instante é convertido para o fuso do restaurante; exceções por data têm precedência
+// sobre os horários semanais e intervalos com closes_at < opens_at seguem até o dia seguinte
 func (r *Restaurant) CalculateIsOpen(now time.Time) bool {
 	if r.Status != StatusOpen {
 		return false
 	}
 
-	if len(r.OpeningHours) == 0 {
-		return false
+	// Exceções em hoje ou ontem (que pode transbordar para hoje) exigem a agenda por data
+	local := r.LocalTime(now)
+	if r.SpecialHoursOn(local.Format(DateLayout)) != nil || r.SpecialHoursOn(local.AddDate(0, 0, -1).Format(DateLayout)) != nil {
+		return r.CurrentInterval(now) != nil
+	}
+
+	return NewWeeklySchedule(r.OpeningHours).Contains(MinuteOfWeek(local))
+}
+
+// ValidateProfile valida os dados cadastrais do restaurante
+// Compartilhada entre criação e atualização de perfil
+func (r *Restaurant) ValidateProfile() error {
+	if r.Name == "" {
+		return NewValidationError("name_required", "name is required")
 	}
 
-	currentWeekday := int(now.Weekday())
-	currentMinutes := now.Hour()*60 + now.Minute()
-
-	for _, hour := range r.OpeningHours {
-		if hour.Weekday == currentWeekday {
-			// Se closes_at < opens_at, significa que fecha no dia seguinte
-			if hour.ClosesAt < hour.OpensAt {
-				// Caso especial: horário que cruza a meia-noite
-				// Exemplo: abre 22:00 (1320min) e fecha 02:00 (120min)
-				if currentMinutes >= hour.OpensAt || currentMinutes < hour.ClosesAt {
-					return true
-				}
-			} else {
-				// Caso normal: horário no mesmo dia
-				if currentMinutes >= hour.OpensAt && currentMinutes < hour.ClosesAt {
-					return true
-				}
-			}
+	if r.DeliveryFee < 0 {
+		return NewValidationError("invalid_delivery_fee", "delivery fee cannot be negative")
+	}
+
+	if r.MinOrderValue < 0 {
+		return NewValidationError("invalid_min_order_value", "min order value cannot be negative")
+	}
+
+	if r.PreparationTimeMin < 0 {
+		return NewValidationError("invalid_preparation_time", "preparation time cannot be negative")
+	}
+
+	if r.Timezone != "" {
+		if err := ValidateTimezone(r.Timezone); err != nil {
+			return err
 		}
 	}
 
-	return false
+	return nil
 }
-
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxSearchQueryLength limita o tamanho do termo de busca textual
+const MaxSearchQueryLength = 200
+
+// RestaurantFilter representa os filtros da listagem de restaurantes
+// Campos vazios ou nil não filtram
+type RestaurantFilter struct {
+	Query                 string // Busca textual (nome, categoria e descrição); ordena por relevância
+	Category              string
+	Status                string
+	City                  string
+	State                 string
+	SupportsDelivery      *bool
+	SupportsPickup        *bool
+	MaxDeliveryFee        *int64 // centavos
+	MaxPreparationTimeMin *int
+	OpenNow               bool      // Avaliado pelo banco no fuso de cada restaurante (restaurant_is_open)
+	DeliversTo            *GeoPoint // Avaliado em memória contra as áreas de entrega de cada restaurante
+}
+
+// Normalize padroniza os filtros textuais (status e UF em maiúsculas, sem espaços nas pontas)
+func (f *RestaurantFilter) Normalize() {
+	f.Query = strings.TrimSpace(f.Query)
+	f.Category = strings.TrimSpace(f.Category)
+	f.Status = strings.ToUpper(strings.TrimSpace(f.Status))
+	f.City = strings.TrimSpace(f.City)
+	f.State = strings.ToUpper(strings.TrimSpace(f.State))
+}
+
+// Validate valida os valores dos filtros
+func (f *RestaurantFilter) Validate() error {
+	if len(f.Query) > MaxSearchQueryLength {
+		return NewValidationError("invalid_query", fmt.Sprintf("q must have at most %d characters", MaxSearchQueryLength))
+	}
+
+	switch f.Status {
+	case "", StatusDraft, StatusOpen, StatusClosed, StatusSuspended:
+	default:
+		return NewValidationError("invalid_status", fmt.Sprintf("invalid status: %s", f.Status))
+	}
+
+	if f.State != "" && !IsValidState(f.State) {
+		return NewValidationError("invalid_state", fmt.Sprintf("invalid state: %s", f.State))
+	}
+
+	if f.MaxDeliveryFee != nil && *f.MaxDeliveryFee < 0 {
+		return NewValidationError("invalid_max_delivery_fee", "max delivery fee cannot be negative")
+	}
+
+	if f.MaxPreparationTimeMin != nil && *f.MaxPreparationTimeMin < 0 {
+		return NewValidationError("invalid_max_preparation_time", "max preparation time cannot be negative")
+	}
+
+	if f.DeliversTo != nil {
+		if err := f.DeliversTo.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// HasComputedFilters informa se há filtros que só podem ser avaliados em memória
+// Áreas de entrega em polígono GeoJSON não são avaliadas pelo banco
+func (f *RestaurantFilter) HasComputedFilters() bool {
+	return f.DeliversTo != nil
+}
+
+// MatchesComputed avalia os filtros que dependem do aggregate carregado (áreas de entrega)
+// Os demais filtros, inclusive OpenNow, já foram aplicados pelo banco
+func (f *RestaurantFilter) MatchesComputed(restaurant *Restaurant) bool {
+	return f.DeliversTo == nil || restaurant.DeliversTo(*f.DeliversTo)
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Campos aceitos no parâmetro sort da listagem
+const (
+	SortRating             = "rating"
+	SortTotalReviews       = "total_reviews"
+	SortDeliveryFee        = "delivery_fee"
+	SortMinOrderValue      = "min_order_value"
+	SortPreparationTimeMin = "preparation_time_min"
+	SortName               = "name"
+	SortDistance           = "distance"
+)
+
+// unknownDistanceSortKey posiciona restaurantes sem coordenadas no fim da ordenação por distância
+// Deve ser o mesmo valor usado na query ListRestaurantsSorted
+const unknownDistanceSortKey = 1e15
+
+// sortFields é a whitelist de campos ordenáveis
+var sortFields = map[string]bool{
+	SortRating:             true,
+	SortTotalReviews:       true,
+	SortDeliveryFee:        true,
+	SortMinOrderValue:      true,
+	SortPreparationTimeMin: true,
+	SortName:               true,
+	SortDistance:           true,
+}
+
+// RestaurantSort representa a ordenação da listagem ("-rating" = rating decrescente)
+// Field vazio mantém a ordenação padrão (created_at decrescente ou relevância na busca)
+// Empates são sempre desfeitos por id na mesma direção, mantendo a paginação por cursor estável
+type RestaurantSort struct {
+	Field  string
+	Desc   bool
+	Origin *GeoPoint // Ponto de referência para ordenar por distância
+}
+
+// ParseRestaurantSort interpreta o parâmetro sort (ex: "rating", "-delivery_fee")
+func ParseRestaurantSort(raw string) (RestaurantSort, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return RestaurantSort{}, nil
+	}
+
+	sort := RestaurantSort{Field: raw}
+	if strings.HasPrefix(raw, "-") {
+		sort.Field, sort.Desc = raw[1:], true
+	}
+	if !sortFields[sort.Field] {
+		return RestaurantSort{}, NewValidationError("invalid_sort", fmt.Sprintf("invalid sort: %s", raw))
+	}
+	return sort, nil
+}
+
+// Validate exige o ponto de referência na ordenação por distância
+func (s RestaurantSort) Validate() error {
+	if s.Origin != nil {
+		if err := s.Origin.Validate(); err != nil {
+			return err
+		}
+	}
+	if s.Field == SortDistance && s.Origin == nil {
+		return NewValidationError("origin_required", "lat and lng are required to sort by distance")
+	}
+	return nil
+}
+
+// IsDefault informa se a ordenação padrão deve ser usada
+func (s RestaurantSort) IsDefault() bool {
+	return s.Field == ""
+}
+
+// String retorna a forma canônica do parâmetro sort ("" para a ordenação padrão)
+func (s RestaurantSort) String() string {
+	if s.Desc {
+		return "-" + s.Field
+	}
+	return s.Field
+}
+
+// Direction retorna 1 para ordem crescente e -1 para decrescente
+func (s RestaurantSort) Direction() float64 {
+	if s.Desc {
+		return -1
+	}
+	return 1
+}
+
+// keys calcula as chaves de ordenação do restaurante para o cursor de ListRestaurantsSorted:
+// number já vem multiplicado pela direção e text cobre name
+func (s RestaurantSort) keys(restaurant *Restaurant) (number float64, text *string) {
+	switch s.Field {
+	case SortRating:
+		return s.Direction() * float64(restaurant.Rating), nil
+	case SortTotalReviews:
+		return s.Direction() * float64(restaurant.TotalReviews), nil
+	case SortDeliveryFee:
+		return s.Direction() * float64(restaurant.DeliveryFee), nil
+	case SortMinOrderValue:
+		return s.Direction() * float64(restaurant.MinOrderValue), nil
+	case SortPreparationTimeMin:
+		return s.Direction() * float64(restaurant.PreparationTimeMin), nil
+	case SortDistance:
+		if restaurant.DistanceMeters == nil {
+			return unknownDistanceSortKey, nil
+		}
+		return s.Direction() * *restaurant.DistanceMeters, nil
+	case SortName:
+		name := restaurant.Name
+		return s.Direction() * 0, &name
+	}
+	return 0, nil
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRestaurantSort(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want RestaurantSort
+	}{
+		{"", RestaurantSort{}},
+		{"rating", RestaurantSort{Field: SortRating}},
+		{"-rating", RestaurantSort{Field: SortRating, Desc: true}},
+		{" -delivery_fee ", RestaurantSort{Field: SortDeliveryFee, Desc: true}},
+		{"name", RestaurantSort{Field: SortName}},
+	}
+
+	for _, tt := range tests {
+		// Output
+		sort, err := ParseRestaurantSort(tt.raw)
+
+		// Assert
+		require.NoError(t, err, tt.raw)
+		assert.Equal(t, tt.want, sort, tt.raw)
+	}
+}
+
+func TestParseRestaurantSort_Invalid(t *testing.T) {
+	// Input
+	raws := []string{"created_at;drop", "--rating", "-", "Rating", "slug"}
+
+	for _, raw := range raws {
+		// Output
+		_, err := ParseRestaurantSort(raw)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrValidation, raw)
+	}
+}
+
+func TestRestaurantSort_Validate_DistanceRequiresOrigin(t *testing.T) {
+	// Input
+	withoutOrigin := RestaurantSort{Field: SortDistance}
+	withOrigin := RestaurantSort{Field: SortDistance, Origin: &GeoPoint{Lat: -23.55, Lng: -46.63}}
+	invalidOrigin := RestaurantSort{Field: SortRating, Origin: &GeoPoint{Lat: 91, Lng: 0}}
+
+	// Assert
+	assert.ErrorIs(t, withoutOrigin.Validate(), ErrValidation)
+	assert.NoError(t, withOrigin.Validate())
+	assert.ErrorIs(t, invalidOrigin.Validate(), ErrValidation)
+}
+
+func TestCursorFor_SortKeys(t *testing.T) {
+	// Input
+	distance := 1250.5
+	restaurant := &Restaurant{
+		ID:             uuid.New(),
+		Name:           "Cantina da Nonna",
+		Rating:         4,
+		DeliveryFee:    799,
+		DistanceMeters: &distance,
+		CreatedAt:      time.Date(2024, time.March, 4, 11, 30, 0, 0, time.UTC),
+	}
+
+	// Output
+	byRating := CursorFor(restaurant, RestaurantSort{Field: SortRating, Desc: true})
+	byFee := CursorFor(restaurant, RestaurantSort{Field: SortDeliveryFee})
+	byName := CursorFor(restaurant, RestaurantSort{Field: SortName, Desc: true})
+	byDistance := CursorFor(restaurant, RestaurantSort{Field: SortDistance})
+	unknownDistance := CursorFor(&Restaurant{ID: uuid.New()}, RestaurantSort{Field: SortDistance, Desc: true})
+
+	// Assert: a chave numérica já carrega o sinal da direção
+	assert.Equal(t, "-rating", byRating.Sort)
+	assert.Equal(t, -4.0, byRating.SortNumber)
+	assert.Equal(t, 799.0, byFee.SortNumber)
+	assert.Nil(t, byFee.SortText)
+	require.NotNil(t, byName.SortText)
+	assert.Equal(t, "Cantina da Nonna", *byName.SortText)
+	assert.Equal(t, distance, byDistance.SortNumber)
+	assert.Equal(t, unknownDistanceSortKey, unknownDistance.SortNumber)
+}
+
+func TestListCursor_EncodeDecode_WithSort(t *testing.T) {
+	// Input
+	restaurant := &Restaurant{ID: uuid.New(), Name: "Bar: do Zé", CreatedAt: time.Now().UTC()}
+	cursor := CursorFor(restaurant, RestaurantSort{Field: SortName})
+
+	// Output
+	token, err := cursor.Encode()
+	require.NoError(t, err)
+	decoded, err := DecodeCursor(token)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "name", decoded.Sort)
+	require.NotNil(t, decoded.SortText)
+	assert.Equal(t, "Bar: do Zé", *decoded.SortText)
+	assert.Equal(t, restaurant.ID, decoded.ID)
+}
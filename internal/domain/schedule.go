@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// scheduleLookaheadDays é quantos dias à frente são considerados ao procurar a próxima abertura
+const scheduleLookaheadDays = 8
+
+// OpenInterval é um período concreto de funcionamento, no fuso do restaurante
+type OpenInterval struct {
+	OpensAt  time.Time `json:"opens_at"`
+	ClosesAt time.Time `json:"closes_at"`
+}
+
+// CurrentInterval retorna o intervalo de funcionamento que contém now (nil se fechado)
+func (r *Restaurant) CurrentInterval(now time.Time) *OpenInterval {
+	if r.Status != StatusOpen {
+		return nil
+	}
+
+	for _, interval := range r.scheduleAround(now) {
+		if !now.Before(interval.OpensAt) && now.Before(interval.ClosesAt) {
+			return &interval
+		}
+	}
+	return nil
+}
+
+// NextOpening retorna o próximo instante, estritamente após now, em que o restaurante abre
+// Retorna nil se o restaurante não está OPEN ou não abre na próxima semana
+func (r *Restaurant) NextOpening(now time.Time) *time.Time {
+	if r.Status != StatusOpen {
+		return nil
+	}
+
+	for _, interval := range r.scheduleAround(now) {
+		if interval.OpensAt.After(now) {
+			return &interval.OpensAt
+		}
+	}
+	return nil
+}
+
+// NextClosing retorna o próximo instante, estritamente após now, em que o restaurante fecha
+// Se estiver aberto, é o fim do intervalo atual
+func (r *Restaurant) NextClosing(now time.Time) *time.Time {
+	if r.Status != StatusOpen {
+		return nil
+	}
+
+	for _, interval := range r.scheduleAround(now) {
+		if interval.ClosesAt.After(now) {
+			return &interval.ClosesAt
+		}
+	}
+	return nil
+}
+
+// RefreshAvailability preenche os campos computados IsOpen, NextOpensAt e NextClosesAt
+func (r *Restaurant) RefreshAvailability(now time.Time) {
+	r.IsOpen = r.CalculateIsOpen(now)
+	r.NextOpensAt = r.NextOpening(now)
+	r.NextClosesAt = r.NextClosing(now)
+}
+
+// scheduleAround retorna os intervalos concretos de ontem até scheduleLookaheadDays à frente
+// Ontem entra para cobrir intervalos que cruzam a meia-noite; intervalos contíguos são mesclados
+func (r *Restaurant) scheduleAround(now time.Time) []OpenInterval {
+	local := r.LocalTime(now)
+	year, month, day := local.Date()
+
+	var intervals []OpenInterval
+	for offset := -1; offset <= scheduleLookaheadDays; offset++ {
+		date := time.Date(year, month, day+offset, 0, 0, 0, 0, local.Location())
+		intervals = append(intervals, r.intervalsStartingOn(date)...)
+	}
+
+	return mergeIntervals(intervals)
+}
+
+// intervalsStartingOn retorna os intervalos que começam na data local informada
+// Uma exceção para a data substitui os horários semanais; closes_at < opens_at termina no dia seguinte
+func (r *Restaurant) intervalsStartingOn(date time.Time) []OpenInterval {
+	var daily []TimeInterval
+	if special := r.SpecialHoursOn(date.Format(DateLayout)); special != nil {
+		if special.Closed {
+			return nil
+		}
+		daily = special.Intervals
+	} else {
+		for _, hour := range r.OpeningHours {
+			if hour.Weekday == int(date.Weekday()) {
+				daily = append(daily, TimeInterval{OpensAt: hour.OpensAt, ClosesAt: hour.ClosesAt})
+			}
+		}
+	}
+
+	year, month, day := date.Date()
+	intervals := make([]OpenInterval, 0, len(daily))
+	for _, interval := range daily {
+		duration := dailyDuration(interval.OpensAt, interval.ClosesAt)
+		if duration == 0 {
+			continue // Intervalo vazio
+		}
+
+		// time.Date normaliza os minutos, respeitando o fuso (inclusive horário de verão)
+		intervals = append(intervals, OpenInterval{
+			OpensAt:  time.Date(year, month, day, 0, interval.OpensAt, 0, 0, date.Location()),
+			ClosesAt: time.Date(year, month, day, 0, interval.OpensAt+duration, 0, 0, date.Location()),
+		})
+	}
+	return intervals
+}
+
+// mergeIntervals ordena os intervalos e une os que se sobrepõem ou se tocam
+func mergeIntervals(intervals []OpenInterval) []OpenInterval {
+	slices.SortFunc(intervals, func(a, b OpenInterval) int {
+		return a.OpensAt.Compare(b.OpensAt)
+	})
+
+	merged := make([]OpenInterval, 0, len(intervals))
+	for _, interval := range intervals {
+		if n := len(merged); n > 0 && !interval.OpensAt.After(merged[n-1].ClosesAt) {
+			if interval.ClosesAt.After(merged[n-1].ClosesAt) {
+				merged[n-1].ClosesAt = interval.ClosesAt
+			}
+			continue
+		}
+		merged = append(merged, interval)
+	}
+	return merged
+}
@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// overnightRestaurant abre às sextas das 22:00 às 02:00 do sábado, no fuso de São Paulo
+func overnightRestaurant() *Restaurant {
+	return &Restaurant{
+		Status:       StatusOpen,
+		Timezone:     "America/Sao_Paulo",
+		OpeningHours: []OpeningHour{{Weekday: int(time.Friday), OpensAt: 22 * 60, ClosesAt: 2 * 60}},
+	}
+}
+
+func saoPaulo(t *testing.T) *time.Location {
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+	return loc
+}
+
+func TestRestaurant_Schedule_BeforeOvernightOpening(t *testing.T) {
+	// Input: sexta-feira 20:00
+	loc := saoPaulo(t)
+	now := time.Date(2024, time.March, 8, 20, 0, 0, 0, loc)
+	restaurant := overnightRestaurant()
+
+	// Output
+	current := restaurant.CurrentInterval(now)
+	nextOpening := restaurant.NextOpening(now)
+	nextClosing := restaurant.NextClosing(now)
+
+	// Assert
+	assert.Nil(t, current)
+	require.NotNil(t, nextOpening)
+	require.NotNil(t, nextClosing)
+	assert.True(t, nextOpening.Equal(time.Date(2024, time.March, 8, 22, 0, 0, 0, loc)))
+	assert.True(t, nextClosing.Equal(time.Date(2024, time.March, 9, 2, 0, 0, 0, loc)))
+}
+
+func TestRestaurant_Schedule_AfterMidnightSpillOver(t *testing.T) {
+	// Input: sábado 01:30, ainda dentro do horário de sexta
+	loc := saoPaulo(t)
+	now := time.Date(2024, time.March, 9, 1, 30, 0, 0, loc)
+	restaurant := overnightRestaurant()
+
+	// Output
+	current := restaurant.CurrentInterval(now)
+	nextClosing := restaurant.NextClosing(now)
+	nextOpening := restaurant.NextOpening(now)
+
+	// Assert
+	require.NotNil(t, current)
+	assert.True(t, current.OpensAt.Equal(time.Date(2024, time.March, 8, 22, 0, 0, 0, loc)))
+	assert.True(t, nextClosing.Equal(time.Date(2024, time.March, 9, 2, 0, 0, 0, loc)))
+	assert.True(t, nextOpening.Equal(time.Date(2024, time.March, 15, 22, 0, 0, 0, loc)))
+	assert.True(t, restaurant.CalculateIsOpen(now))
+}
+
+func TestRestaurant_Schedule_OvernightDoesNotOpenSameDayEarlyMorning(t *testing.T) {
+	// Input: sexta-feira 01:00 — o intervalo de sexta só começa às 22:00
+	loc := saoPaulo(t)
+	now := time.Date(2024, time.March, 8, 1, 0, 0, 0, loc)
+	restaurant := overnightRestaurant()
+
+	// Output
+	isOpen := restaurant.CalculateIsOpen(now)
+
+	// Assert
+	assert.False(t, isOpen)
+}
+
+func TestRestaurant_Schedule_ContiguousIntervalsAreMerged(t *testing.T) {
+	// Input: sexta 18:00–00:00 seguido de sábado 00:00–03:00
+	loc := saoPaulo(t)
+	now := time.Date(2024, time.March, 8, 23, 0, 0, 0, loc)
+	restaurant := &Restaurant{
+		Status:   StatusOpen,
+		Timezone: "America/Sao_Paulo",
+		OpeningHours: []OpeningHour{
+			{Weekday: int(time.Friday), OpensAt: 18 * 60, ClosesAt: 0},
+			{Weekday: int(time.Saturday), OpensAt: 0, ClosesAt: 3 * 60},
+		},
+	}
+
+	// Output
+	nextClosing := restaurant.NextClosing(now)
+
+	// Assert
+	require.NotNil(t, nextClosing)
+	assert.True(t, nextClosing.Equal(time.Date(2024, time.March, 9, 3, 0, 0, 0, loc)))
+}
+
+func TestRestaurant_Schedule_SkipsSpecialClosure(t *testing.T) {
+	// Input: a sexta 08/03 está fechada por exceção
+	loc := saoPaulo(t)
+	now := time.Date(2024, time.March, 8, 20, 0, 0, 0, loc)
+	restaurant := overnightRestaurant()
+	restaurant.SpecialHours = []SpecialHours{{Date: "2024-03-08", Closed: true}}
+
+	// Output
+	nextOpening := restaurant.NextOpening(now)
+
+	// Assert
+	require.NotNil(t, nextOpening)
+	assert.True(t, nextOpening.Equal(time.Date(2024, time.March, 15, 22, 0, 0, 0, loc)))
+}
+
+func TestRestaurant_RefreshAvailability_NotOpenStatus(t *testing.T) {
+	// Input
+	now := time.Date(2024, time.March, 8, 23, 0, 0, 0, time.UTC)
+	restaurant := overnightRestaurant()
+	restaurant.Status = StatusClosed
+
+	// Output
+	restaurant.RefreshAvailability(now)
+
+	// Assert
+	assert.False(t, restaurant.IsOpen)
+	assert.Nil(t, restaurant.NextOpensAt)
+	assert.Nil(t, restaurant.NextClosesAt)
+}
+
+func TestRestaurant_RefreshAvailability_JSONKeys(t *testing.T) {
+	// Input: sexta 23:00 em São Paulo, dentro do intervalo noturno
+	now := time.Date(2024, time.March, 9, 2, 0, 0, 0, time.UTC)
+	restaurant := overnightRestaurant()
+	restaurant.RefreshAvailability(now)
+
+	// Output
+	body, err := json.Marshal(restaurant)
+	require.NoError(t, err)
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(body, &fields))
+
+	// Assert
+	assert.Contains(t, fields, "next_opens_at")
+	assert.Contains(t, fields, "next_closes_at")
+	assert.NotContains(t, fields, "NextOpensAt")
+	assert.NotContains(t, fields, "NextClosesAt")
+}
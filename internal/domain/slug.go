@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MaxSlugLength é o tamanho da coluna restaurants.slug
+const MaxSlugLength = 255
+
+// maxSlugSuffixLength reserva espaço para o sufixo de desambiguação ("-9999999999")
+const maxSlugSuffixLength = 11
+
+// slugPattern aceita letras minúsculas, dígitos e hífens entre eles ("pizza-do-joao")
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// reservedSlugs colidem com rotas fixas ou termos que a plataforma pode vir a usar
+var reservedSlugs = map[string]bool{
+	"admin":      true,
+	"api":        true,
+	"categories": true,
+	"edit":       true,
+	"facets":     true,
+	"health":     true,
+	"nearby":     true,
+	"new":        true,
+	"search":     true,
+}
+
+// ValidateSlug valida um slug informado pelo cliente
+func ValidateSlug(slug string) error {
+	if !slugPattern.MatchString(slug) || len(slug) > MaxSlugLength {
+		return NewValidationError("invalid_slug", "slug must contain only lowercase letters, digits and single hyphens")
+	}
+	if IsReservedSlug(slug) {
+		return NewValidationError("reserved_slug", fmt.Sprintf("slug %s is reserved", slug))
+	}
+	return nil
+}
+
+// IsReservedSlug informa se o slug é uma palavra reservada
+func IsReservedSlug(slug string) bool {
+	return reservedSlugs[slug]
+}
+
+// SlugBase limita um slug gerado para que ainda caiba o sufixo de desambiguação
+func SlugBase(slug string) string {
+	if len(slug) > MaxSlugLength-maxSlugSuffixLength {
+		slug = strings.TrimRight(slug[:MaxSlugLength-maxSlugSuffixLength], "-")
+	}
+	return slug
+}
+
+// NextFreeSlug retorna o primeiro de base, base-2, base-3, ... que não está em taken nem é reservado
+func NextFreeSlug(base string, taken []string) string {
+	used := make(map[string]bool, len(taken))
+	for _, slug := range taken {
+		used[slug] = true
+	}
+
+	candidate := base
+	for n := 2; used[candidate] || IsReservedSlug(candidate); n++ {
+		candidate = base + "-" + strconv.Itoa(n)
+	}
+	return candidate
+}
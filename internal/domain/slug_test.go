@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextFreeSlug(t *testing.T) {
+	tests := []struct {
+		name  string
+		base  string
+		taken []string
+		want  string
+	}{
+		{name: "free", base: "pizza-do-joao", want: "pizza-do-joao"},
+		{name: "first suffix", base: "pizza-do-joao", taken: []string{"pizza-do-joao"}, want: "pizza-do-joao-2"},
+		{name: "fills gap", base: "pizza-do-joao", taken: []string{"pizza-do-joao", "pizza-do-joao-3"}, want: "pizza-do-joao-2"},
+		{name: "reserved base", base: "nearby", want: "nearby-2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Output
+			slug := NextFreeSlug(tt.base, tt.taken)
+
+			// Assert
+			assert.Equal(t, tt.want, slug)
+			assert.NoError(t, ValidateSlug(slug))
+		})
+	}
+}
+
+func TestSlugBase_LeavesRoomForSuffix(t *testing.T) {
+	// Input
+	long := strings.Repeat("a", MaxSlugLength-maxSlugSuffixLength-1) + "-" + strings.Repeat("b", 20)
+
+	// Output
+	base := SlugBase(long)
+
+	// Assert: o hífen final do corte é removido e o sufixo ainda cabe
+	assert.Equal(t, strings.Repeat("a", MaxSlugLength-maxSlugSuffixLength-1), base)
+	assert.NoError(t, ValidateSlug(base+"-9999999999"))
+}
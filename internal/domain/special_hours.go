@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DateLayout é o formato das datas de calendário (sem horário) usadas na API
+const DateLayout = "2006-01-02"
+
+// SpecialHours é uma exceção ao horário semanal em uma data específica
+// Closed fecha o dia inteiro; caso contrário, Intervals substitui os horários semanais da data
+type SpecialHours struct {
+	RestaurantID uuid.UUID      `json:"restaurant_id"`
+	Date         string         `json:"date"` // "2006-01-02", no fuso do restaurante
+	Closed       bool           `json:"closed"`
+	Intervals    []TimeInterval `json:"intervals"`
+	Note         string         `json:"note,omitempty"` // Ex: "Natal", "Réveillon"
+}
+
+// TimeInterval é um intervalo de funcionamento dentro de um dia
+type TimeInterval struct {
+	OpensAt  int `json:"opens_at"`  // Minutos a partir da meia-noite (0-1439)
+	ClosesAt int `json:"closes_at"` // Minutos a partir da meia-noite; menor que OpensAt termina no dia seguinte
+}
+
+// ParseDate valida e converte uma data no formato DateLayout
+func ParseDate(value string) (time.Time, error) {
+	date, err := time.Parse(DateLayout, value)
+	if err != nil {
+		return time.Time{}, NewValidationError("invalid_date", fmt.Sprintf("invalid date %q, expected YYYY-MM-DD", value))
+	}
+	return date, nil
+}
+
+// dayRange converte o intervalo em minutos a partir da meia-noite da data
+// Um intervalo que cruza a meia-noite termina depois de 1439
+func (i TimeInterval) dayRange() WeekRange {
+	return WeekRange{Start: i.OpensAt, End: i.OpensAt + dailyDuration(i.OpensAt, i.ClosesAt)}
+}
+
+// Validate valida a exceção de horário
+func (s *SpecialHours) Validate() error {
+	if _, err := ParseDate(s.Date); err != nil {
+		return err
+	}
+
+	if s.Closed {
+		if len(s.Intervals) > 0 {
+			return NewValidationError("closed_with_intervals", "a closed day cannot have intervals")
+		}
+		return nil
+	}
+
+	if len(s.Intervals) == 0 {
+		return NewValidationError("intervals_required", "intervals are required when the day is not closed")
+	}
+
+	for _, interval := range s.Intervals {
+		if interval.OpensAt < 0 || interval.OpensAt >= 1440 {
+			return NewValidationError("invalid_opens_at", "opens_at must be between 0 and 1439")
+		}
+		if interval.ClosesAt < 0 || interval.ClosesAt >= 1440 {
+			return NewValidationError("invalid_closes_at", "closes_at must be between 0 and 1439")
+		}
+		if interval.OpensAt == interval.ClosesAt {
+			return NewValidationError("empty_interval", "opens_at and closes_at cannot be equal")
+		}
+	}
+
+	// Intervalos da data como minutos a partir da meia-noite; o transbordo vai além de 1439
+	for i := 0; i < len(s.Intervals); i++ {
+		for j := i + 1; j < len(s.Intervals); j++ {
+			a, b := s.Intervals[i].dayRange(), s.Intervals[j].dayRange()
+			if a.Overlaps(b) {
+				return NewValidationError("special_hours_overlap", fmt.Sprintf("special hours overlap on %s", s.Date))
+			}
+		}
+	}
+
+	return nil
+}
+
+// SpecialHoursOn retorna a exceção cadastrada para a data, se houver
+func (r *Restaurant) SpecialHoursOn(date string) *SpecialHours {
+	for i := range r.SpecialHours {
+		if r.SpecialHours[i].Date == date {
+			return &r.SpecialHours[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestaurant_CalculateIsOpen_SpecialHoursTakePrecedence(t *testing.T) {
+	// Input: quarta-feira 25/12/2024, 12:00 em São Paulo (15:00 UTC)
+	now := time.Date(2024, time.December, 25, 15, 0, 0, 0, time.UTC)
+	weekly := []OpeningHour{{Weekday: int(time.Wednesday), OpensAt: 8 * 60, ClosesAt: 22 * 60}}
+
+	tests := []struct {
+		name     string
+		special  []SpecialHours
+		expected bool
+	}{
+		{"no override follows weekly hours", nil, true},
+		{"closed for the day", []SpecialHours{{Date: "2024-12-25", Closed: true}}, false},
+		{"replacement interval excludes now", []SpecialHours{{Date: "2024-12-25", Intervals: []TimeInterval{{OpensAt: 18 * 60, ClosesAt: 23 * 60}}}}, false},
+		{"replacement interval includes now", []SpecialHours{{Date: "2024-12-25", Intervals: []TimeInterval{{OpensAt: 11 * 60, ClosesAt: 14 * 60}}}}, true},
+		{"override on another date is ignored", []SpecialHours{{Date: "2024-12-31", Closed: true}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restaurant := &Restaurant{
+				Status:       StatusOpen,
+				Address:      &Address{State: "SP"},
+				OpeningHours: weekly,
+				SpecialHours: tt.special,
+			}
+
+			// Output
+			isOpen := restaurant.CalculateIsOpen(now)
+
+			// Assert
+			assert.Equal(t, tt.expected, isOpen)
+		})
+	}
+}
+
+func TestRestaurant_CalculateIsOpen_SpecialHoursAcrossMidnight(t *testing.T) {
+	// Input: terça-feira 31/12/2024 com exceção das 20:00 às 02:00 em São Paulo (UTC-3)
+	// A madrugada do próprio dia 31 pertence ao dia 30; o transbordo vale para a madrugada do dia 01
+	special := []SpecialHours{{Date: "2024-12-31", Intervals: []TimeInterval{{OpensAt: 20 * 60, ClosesAt: 2 * 60}}}}
+	weekly := []OpeningHour{{Weekday: int(time.Tuesday), OpensAt: 8 * 60, ClosesAt: 18 * 60}}
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		expected bool
+	}{
+		{"early morning of the date itself", time.Date(2024, time.December, 31, 4, 0, 0, 0, time.UTC), false},
+		{"evening of the date", time.Date(2025, time.January, 1, 2, 0, 0, 0, time.UTC), true},
+		{"spill-over into the next day", time.Date(2025, time.January, 1, 4, 0, 0, 0, time.UTC), true},
+		{"after the spill-over ends", time.Date(2025, time.January, 1, 6, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restaurant := &Restaurant{
+				Status:       StatusOpen,
+				Address:      &Address{State: "SP"},
+				OpeningHours: weekly,
+				SpecialHours: special,
+			}
+
+			// Output
+			isOpen := restaurant.CalculateIsOpen(tt.now)
+
+			// Assert
+			assert.Equal(t, tt.expected, isOpen)
+		})
+	}
+}
+
+func TestSpecialHours_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		special SpecialHours
+		code    string
+	}{
+		{"valid closure", SpecialHours{Date: "2024-12-25", Closed: true}, ""},
+		{"valid intervals", SpecialHours{Date: "2024-12-31", Intervals: []TimeInterval{{OpensAt: 660, ClosesAt: 900}, {OpensAt: 1080, ClosesAt: 120}}}, ""},
+		{"invalid date", SpecialHours{Date: "25/12/2024", Closed: true}, "invalid_date"},
+		{"closed with intervals", SpecialHours{Date: "2024-12-25", Closed: true, Intervals: []TimeInterval{{OpensAt: 0, ClosesAt: 60}}}, "closed_with_intervals"},
+		{"open without intervals", SpecialHours{Date: "2024-12-25"}, "intervals_required"},
+		{"opens_at out of range", SpecialHours{Date: "2024-12-25", Intervals: []TimeInterval{{OpensAt: 1440, ClosesAt: 60}}}, "invalid_opens_at"},
+		{"empty interval", SpecialHours{Date: "2024-12-25", Intervals: []TimeInterval{{OpensAt: 600, ClosesAt: 600}}}, "empty_interval"},
+		{"overlap across midnight", SpecialHours{Date: "2024-12-25", Intervals: []TimeInterval{{OpensAt: 1320, ClosesAt: 120}, {OpensAt: 1380, ClosesAt: 1410}}}, "special_hours_overlap"},
+		{"overnight spill does not collide with same-day morning", SpecialHours{Date: "2024-12-25", Intervals: []TimeInterval{{OpensAt: 1320, ClosesAt: 120}, {OpensAt: 60, ClosesAt: 300}}}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Output
+			err := tt.special.Validate()
+
+			// Assert
+			if tt.code == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, NewValidationError(tt.code, ""))
+		})
+	}
+}
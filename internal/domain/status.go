@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StatusEvent representa uma ação que altera o status do restaurante
+type StatusEvent string
+
+// Eventos da máquina de estados de status
+const (
+	EventOpen      StatusEvent = "open"
+	EventClose     StatusEvent = "close"
+	EventSuspend   StatusEvent = "suspend"
+	EventReinstate StatusEvent = "reinstate"
+)
+
+// StatusChange registra uma transição de status aplicada a um restaurante
+type StatusChange struct {
+	ID           uuid.UUID   `json:"id"`
+	RestaurantID uuid.UUID   `json:"restaurant_id"`
+	FromStatus   string      `json:"from_status"`
+	ToStatus     string      `json:"to_status"`
+	Event        StatusEvent `json:"event"`
+	Reason       string      `json:"reason,omitempty"`
+	Actor        Actor       `json:"actor"`
+	CreatedAt    time.Time   `json:"created_at"`
+}
+
+// statusTransition descreve uma transição permitida e suas guardas
+type statusTransition struct {
+	from           []string
+	to             string
+	adminOnly      bool
+	requiresReason bool
+	guard          func(r *Restaurant) error
+}
+
+// statusTransitions define a máquina de estados do restaurante
+//
+//	DRAFT, CLOSED          --open-->      OPEN       (exige endereço com coordenadas, horários e pagamento)
+//	OPEN                   --close-->     CLOSED
+//	DRAFT, OPEN, CLOSED    --suspend-->   SUSPENDED  (admin, motivo obrigatório)
+//	SUSPENDED              --reinstate--> CLOSED     (admin, motivo obrigatório; o lojista reabre depois)
+var statusTransitions = map[StatusEvent]statusTransition{
+	EventOpen: {
+		from:  []string{StatusDraft, StatusClosed},
+		to:    StatusOpen,
+		guard: (*Restaurant).checkOpenPrerequisites,
+	},
+	EventClose: {
+		from: []string{StatusOpen},
+		to:   StatusClosed,
+	},
+	EventSuspend: {
+		from:           []string{StatusDraft, StatusOpen, StatusClosed},
+		to:             StatusSuspended,
+		adminOnly:      true,
+		requiresReason: true,
+	},
+	EventReinstate: {
+		from:           []string{StatusSuspended},
+		to:             StatusClosed,
+		adminOnly:      true,
+		requiresReason: true,
+	},
+}
+
+// Transition aplica um evento de status ao restaurante
+// Valida permissão do ator, motivo, status de origem e pré-requisitos antes de alterar Status
+// Retorna o registro da mudança, que deve ser persistido junto com o novo status
+func (r *Restaurant) Transition(event StatusEvent, actor Actor, reason string) (*StatusChange, error) {
+	transition, ok := statusTransitions[event]
+	if !ok {
+		return nil, NewValidationError("invalid_status_event", fmt.Sprintf("invalid status event: %s", event))
+	}
+
+	if transition.adminOnly && !actor.IsAdmin() {
+		return nil, NewForbiddenError("admin_required", fmt.Sprintf("only admins can %s a restaurant", event))
+	}
+
+	if transition.requiresReason && reason == "" {
+		return nil, NewValidationError("reason_required", "reason is required")
+	}
+
+	if !slices.Contains(transition.from, r.Status) {
+		return nil, NewConflictError("invalid_status_transition",
+			fmt.Sprintf("cannot %s a restaurant with status %s", event, r.Status))
+	}
+
+	if transition.guard != nil {
+		if err := transition.guard(r); err != nil {
+			return nil, err
+		}
+	}
+
+	change := &StatusChange{
+		ID:           uuid.New(),
+		RestaurantID: r.ID,
+		FromStatus:   r.Status,
+		ToStatus:     transition.to,
+		Event:        event,
+		Reason:       reason,
+		Actor:        actor,
+	}
+
+	r.Status = transition.to
+	r.StatusReason = reason
+	return change, nil
+}
+
+// checkOpenPrerequisites verifica os requisitos para abrir o restaurante (R2/R3)
+func (r *Restaurant) checkOpenPrerequisites() error {
+	if r.Address == nil {
+		return NewValidationError("address_required", "restaurant must have an address to be opened")
+	}
+	if !r.Address.HasCoordinates() {
+		return NewValidationError("address_coordinates_required", "restaurant address must have lat and lng to be opened")
+	}
+	if len(r.OpeningHours) == 0 {
+		return NewValidationError("opening_hours_required", "restaurant must have opening hours to be opened")
+	}
+	if len(r.PaymentMethods) == 0 {
+		return NewValidationError("payment_method_required", "restaurant must have at least one payment method to be opened")
+	}
+	return nil
+}
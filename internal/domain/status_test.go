@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func openableRestaurant(status string) *Restaurant {
+	lat, lng := -23.55, -46.63
+	return &Restaurant{
+		ID:             uuid.New(),
+		Status:         status,
+		Address:        &Address{Lat: &lat, Lng: &lng},
+		OpeningHours:   []OpeningHour{{Weekday: 1, OpensAt: 480, ClosesAt: 1200}},
+		PaymentMethods: []PaymentMethod{{Method: PaymentMethodPIX}},
+	}
+}
+
+func TestRestaurant_Transition_Allowed(t *testing.T) {
+	admin := Actor{ID: "ops", Role: RoleAdmin}
+	merchant := Actor{ID: "owner", Role: RoleMerchant}
+
+	tests := []struct {
+		from   string
+		event  StatusEvent
+		actor  Actor
+		reason string
+		to     string
+	}{
+		{StatusDraft, EventOpen, merchant, "", StatusOpen},
+		{StatusClosed, EventOpen, merchant, "", StatusOpen},
+		{StatusOpen, EventClose, merchant, "", StatusClosed},
+		{StatusOpen, EventSuspend, admin, "fraude", StatusSuspended},
+		{StatusDraft, EventSuspend, admin, "fraude", StatusSuspended},
+		{StatusSuspended, EventReinstate, admin, "regularizado", StatusClosed},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.event)+"_from_"+tt.from, func(t *testing.T) {
+			// Input
+			restaurant := openableRestaurant(tt.from)
+
+			// Output
+			change, err := restaurant.Transition(tt.event, tt.actor, tt.reason)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.to, restaurant.Status)
+			assert.Equal(t, tt.reason, restaurant.StatusReason)
+			assert.Equal(t, tt.from, change.FromStatus)
+			assert.Equal(t, tt.to, change.ToStatus)
+			assert.Equal(t, tt.actor, change.Actor)
+			assert.Equal(t, restaurant.ID, change.RestaurantID)
+		})
+	}
+}
+
+func TestRestaurant_Transition_Rejected(t *testing.T) {
+	admin := Actor{ID: "ops", Role: RoleAdmin}
+	merchant := Actor{ID: "owner", Role: RoleMerchant}
+
+	tests := []struct {
+		name   string
+		from   string
+		event  StatusEvent
+		actor  Actor
+		reason string
+		kind   error
+	}{
+		{"close draft", StatusDraft, EventClose, merchant, "", ErrConflict},
+		{"reopen suspended", StatusSuspended, EventOpen, merchant, "", ErrConflict},
+		{"open already open", StatusOpen, EventOpen, merchant, "", ErrConflict},
+		{"reinstate open", StatusOpen, EventReinstate, admin, "motivo", ErrConflict},
+		{"merchant suspends", StatusOpen, EventSuspend, merchant, "motivo", ErrForbidden},
+		{"suspend without reason", StatusOpen, EventSuspend, admin, "", ErrValidation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Input
+			restaurant := openableRestaurant(tt.from)
+
+			// Output
+			change, err := restaurant.Transition(tt.event, tt.actor, tt.reason)
+
+			// Assert
+			assert.Nil(t, change)
+			assert.ErrorIs(t, err, tt.kind)
+			assert.Equal(t, tt.from, restaurant.Status)
+		})
+	}
+}
+
+func TestRestaurant_Transition_OpenRequiresPrerequisites(t *testing.T) {
+	// Input
+	restaurant := openableRestaurant(StatusDraft)
+	restaurant.PaymentMethods = nil
+
+	// Output
+	_, err := restaurant.Transition(EventOpen, Actor{Role: RoleMerchant}, "")
+
+	// Assert
+	assert.ErrorIs(t, err, ErrValidation)
+	assert.Contains(t, err.Error(), "payment method")
+	assert.Equal(t, StatusDraft, restaurant.Status)
+}
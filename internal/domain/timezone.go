@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	// Embute a base IANA no binário: imagens mínimas não trazem /usr/share/zoneinfo
+	_ "time/tzdata"
+)
+
+// DefaultTimezone é o fuso usado quando não há fuso explícito nem endereço
+const DefaultTimezone = "America/Sao_Paulo"
+
+// stateTimezones mapeia cada UF para o fuso IANA da capital
+// Deve ficar em sincronia com restaurant_timezone (migration 000022), usada pelo filtro open_now
+var stateTimezones = map[string]string{
+	"AC": "America/Rio_Branco",
+	"AL": "America/Maceio",
+	"AM": "America/Manaus",
+	"AP": "America/Belem",
+	"BA": "America/Bahia",
+	"CE": "America/Fortaleza",
+	"DF": "America/Sao_Paulo",
+	"ES": "America/Sao_Paulo",
+	"GO": "America/Sao_Paulo",
+	"MA": "America/Fortaleza",
+	"MG": "America/Sao_Paulo",
+	"MS": "America/Campo_Grande",
+	"MT": "America/Cuiaba",
+	"PA": "America/Belem",
+	"PB": "America/Fortaleza",
+	"PE": "America/Recife",
+	"PI": "America/Fortaleza",
+	"PR": "America/Sao_Paulo",
+	"RJ": "America/Sao_Paulo",
+	"RN": "America/Fortaleza",
+	"RO": "America/Porto_Velho",
+	"RR": "America/Boa_Vista",
+	"RS": "America/Sao_Paulo",
+	"SC": "America/Sao_Paulo",
+	"SE": "America/Maceio",
+	"SP": "America/Sao_Paulo",
+	"TO": "America/Araguaina",
+}
+
+// TimezoneForState retorna o fuso IANA de uma UF (DefaultTimezone se desconhecida)
+func TimezoneForState(state string) string {
+	if tz, ok := stateTimezones[state]; ok {
+		return tz
+	}
+	return DefaultTimezone
+}
+
+// ValidateTimezone valida um identificador de fuso IANA (ex: "America/Manaus")
+func ValidateTimezone(name string) error {
+	if name == "" || name == "Local" {
+		return NewValidationError("invalid_timezone", "timezone must be an IANA name")
+	}
+	if _, err := loadLocation(name); err != nil {
+		return NewValidationError("invalid_timezone", fmt.Sprintf("unknown timezone: %s", name))
+	}
+	return nil
+}
+
+// TimezoneName retorna o fuso efetivo do restaurante
+// Ordem: fuso explícito, fuso da UF do endereço, DefaultTimezone
+func (r *Restaurant) TimezoneName() string {
+	if r.Timezone != "" {
+		return r.Timezone
+	}
+	if r.Address != nil {
+		return TimezoneForState(r.Address.State)
+	}
+	return DefaultTimezone
+}
+
+// locations guarda os *time.Location já carregados, por nome IANA
+// time.LoadLocation relê e decodifica a base a cada chamada; o cache evita isso por requisição
+var locations sync.Map
+
+// loadLocation retorna o *time.Location de um fuso, carregando-o uma única vez
+// Apenas fusos válidos são guardados, então o cache fica limitado à base IANA
+func loadLocation(name string) (*time.Location, error) {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := locations.LoadOrStore(name, loc)
+	return actual.(*time.Location), nil
+}
+
+// Location retorna o *time.Location do fuso efetivo do restaurante
+func (r *Restaurant) Location() *time.Location {
+	loc, err := loadLocation(r.TimezoneName())
+	if err != nil {
+		// Fuso inválido persistido não deve derrubar a leitura; usa o padrão
+		loc, _ = loadLocation(DefaultTimezone)
+	}
+	return loc
+}
+
+// LocalTime converte um instante para o horário local do restaurante
+func (r *Restaurant) LocalTime(t time.Time) time.Time {
+	return t.In(r.Location())
+}
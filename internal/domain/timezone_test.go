@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// openRestaurantAt cria um restaurante aberto das 08:00 às 12:00 toda segunda-feira
+func openRestaurantAt(state string) *Restaurant {
+	return &Restaurant{
+		Status:       StatusOpen,
+		Address:      &Address{State: state},
+		OpeningHours: []OpeningHour{{Weekday: int(time.Monday), OpensAt: 8 * 60, ClosesAt: 12 * 60}},
+	}
+}
+
+func TestRestaurant_CalculateIsOpen_UsesRestaurantTimezone(t *testing.T) {
+	// Input: segunda-feira 11:30 UTC = 08:30 em São Paulo e 07:30 em Manaus
+	now := time.Date(2024, time.March, 4, 11, 30, 0, 0, time.UTC)
+	saoPaulo := openRestaurantAt("SP")
+	manaus := openRestaurantAt("AM")
+
+	// Output
+	saoPauloOpen := saoPaulo.CalculateIsOpen(now)
+	manausOpen := manaus.CalculateIsOpen(now)
+
+	// Assert
+	assert.True(t, saoPauloOpen)
+	assert.False(t, manausOpen)
+}
+
+func TestRestaurant_CalculateIsOpen_WeekdayInLocalTime(t *testing.T) {
+	// Input: terça-feira 02:30 UTC ainda é segunda-feira 23:30 em São Paulo
+	now := time.Date(2024, time.March, 5, 2, 30, 0, 0, time.UTC)
+	restaurant := openRestaurantAt("SP")
+	restaurant.OpeningHours = []OpeningHour{{Weekday: int(time.Monday), OpensAt: 18 * 60, ClosesAt: 24*60 - 1}}
+
+	// Output
+	isOpen := restaurant.CalculateIsOpen(now)
+
+	// Assert
+	assert.True(t, isOpen)
+}
+
+func TestRestaurant_TimezoneName(t *testing.T) {
+	tests := []struct {
+		name       string
+		restaurant Restaurant
+		expected   string
+	}{
+		{"explicit timezone wins", Restaurant{Timezone: "America/Noronha", Address: &Address{State: "AM"}}, "America/Noronha"},
+		{"inferred from state", Restaurant{Address: &Address{State: "AC"}}, "America/Rio_Branco"},
+		{"default without address", Restaurant{}, DefaultTimezone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Output
+			name := tt.restaurant.TimezoneName()
+
+			// Assert
+			assert.Equal(t, tt.expected, name)
+		})
+	}
+}
+
+func TestValidateTimezone(t *testing.T) {
+	// Output
+	validErr := ValidateTimezone("America/Manaus")
+	invalidErr := ValidateTimezone("Brasil/Manaus")
+	localErr := ValidateTimezone("Local")
+
+	// Assert
+	assert.NoError(t, validErr)
+	assert.ErrorIs(t, invalidErr, ErrValidation)
+	assert.ErrorIs(t, localErr, ErrValidation)
+}
+
+func TestRestaurant_Location_Cached(t *testing.T) {
+	restaurant := Restaurant{Timezone: "America/Manaus"}
+
+	// Output
+	first := restaurant.Location()
+	second := restaurant.Location()
+	fallback := (&Restaurant{Timezone: "Brasil/Manaus"}).Location()
+
+	// Assert
+	assert.Equal(t, "America/Manaus", first.String())
+	assert.Same(t, first, second)
+	assert.Equal(t, DefaultTimezone, fallback.String())
+}
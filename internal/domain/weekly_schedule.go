@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Minutos em um dia e em uma semana
+const (
+	MinutesPerDay  = 24 * 60
+	MinutesPerWeek = 7 * MinutesPerDay
+)
+
+// WeekRange é um intervalo semiaberto [Start, End) em minutos da semana
+// 0 = domingo 00:00 e 10079 = sábado 23:59; normalizado, 0 <= Start < End <= MinutesPerWeek
+type WeekRange struct {
+	Start int
+	End   int
+}
+
+// WeeklySchedule é uma agenda semanal normalizada: intervalos ordenados, disjuntos e não contíguos
+type WeeklySchedule []WeekRange
+
+// MinuteOfWeek retorna o minuto da semana de um horário (já convertido para o fuso desejado)
+func MinuteOfWeek(t time.Time) int {
+	return int(t.Weekday())*MinutesPerDay + t.Hour()*60 + t.Minute()
+}
+
+// dailyDuration retorna a duração em minutos de um horário diário
+// closes_at < opens_at termina no dia seguinte; closes_at == opens_at é vazio
+func dailyDuration(opensAt, closesAt int) int {
+	duration := closesAt - opensAt
+	if duration < 0 {
+		duration += MinutesPerDay
+	}
+	return duration
+}
+
+// WeekRanges converte o horário em intervalos semanais normalizados
+// Um horário que atravessa sábado → domingo é dividido em dois
+func (h OpeningHour) WeekRanges() []WeekRange {
+	start := h.Weekday*MinutesPerDay + h.OpensAt
+	end := start + dailyDuration(h.OpensAt, h.ClosesAt)
+
+	switch {
+	case end <= start:
+		return nil
+	case end <= MinutesPerWeek:
+		return []WeekRange{{Start: start, End: end}}
+	default:
+		return []WeekRange{{Start: start, End: MinutesPerWeek}, {Start: 0, End: end - MinutesPerWeek}}
+	}
+}
+
+// Overlaps informa se dois intervalos têm algum minuto em comum
+func (w WeekRange) Overlaps(other WeekRange) bool {
+	return w.Start < other.End && other.Start < w.End
+}
+
+// Contains informa se o minuto da semana está dentro do intervalo
+func (w WeekRange) Contains(minute int) bool {
+	return minute >= w.Start && minute < w.End
+}
+
+// NewWeeklySchedule normaliza os horários semanais: divide na virada da semana, ordena e mescla
+// Intervalos sobrepostos ou contíguos (ex: sexta 18:00–00:00 e sábado 00:00–02:00) viram um só
+func NewWeeklySchedule(hours []OpeningHour) WeeklySchedule {
+	var ranges []WeekRange
+	for _, hour := range hours {
+		ranges = append(ranges, hour.WeekRanges()...)
+	}
+
+	slices.SortFunc(ranges, func(a, b WeekRange) int {
+		return a.Start - b.Start
+	})
+
+	schedule := make(WeeklySchedule, 0, len(ranges))
+	for _, r := range ranges {
+		if n := len(schedule); n > 0 && r.Start <= schedule[n-1].End {
+			schedule[n-1].End = max(schedule[n-1].End, r.End)
+			continue
+		}
+		schedule = append(schedule, r)
+	}
+	return schedule
+}
+
+// Contains informa se o minuto da semana está dentro de algum intervalo (com wraparound)
+func (s WeeklySchedule) Contains(minute int) bool {
+	minute = ((minute % MinutesPerWeek) + MinutesPerWeek) % MinutesPerWeek
+	for _, r := range s {
+		if r.Contains(minute) {
+			return true
+		}
+	}
+	return false
+}
+
+// FindOverlap procura dois horários que se sobrepõem, inclusive por transbordo entre dias
+// (ex: sexta 22:00–02:00 colide com sábado 01:00–05:00). Retorna os índices em hours
+func FindOverlap(hours []OpeningHour) (int, int, bool) {
+	for i := 0; i < len(hours); i++ {
+		for j := i + 1; j < len(hours); j++ {
+			for _, a := range hours[i].WeekRanges() {
+				for _, b := range hours[j].WeekRanges() {
+					if a.Overlaps(b) {
+						return i, j, true
+					}
+				}
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// ValidateOpeningHours valida os horários semanais de um restaurante
+func ValidateOpeningHours(hours []OpeningHour) error {
+	for _, hour := range hours {
+		if hour.Weekday < 0 || hour.Weekday > 6 {
+			return NewValidationError("invalid_weekday", "weekday must be between 0 and 6")
+		}
+		if hour.OpensAt < 0 || hour.OpensAt >= MinutesPerDay {
+			return NewValidationError("invalid_opens_at", "opens_at must be between 0 and 1439")
+		}
+		if hour.ClosesAt < 0 || hour.ClosesAt >= MinutesPerDay {
+			return NewValidationError("invalid_closes_at", "closes_at must be between 0 and 1439")
+		}
+		if hour.OpensAt == hour.ClosesAt {
+			return NewValidationError("empty_interval", "opens_at and closes_at cannot be equal")
+		}
+	}
+
+	if i, j, found := FindOverlap(hours); found {
+		return NewValidationError("opening_hours_overlap",
+			fmt.Sprintf("opening hours overlap: weekday %d %s and weekday %d %s",
+				hours[i].Weekday, formatDailyRange(hours[i].OpensAt, hours[i].ClosesAt),
+				hours[j].Weekday, formatDailyRange(hours[j].OpensAt, hours[j].ClosesAt)))
+	}
+
+	return nil
+}
+
+// formatDailyRange formata um horário diário como "22:00-02:00"
+func formatDailyRange(opensAt, closesAt int) string {
+	return fmt.Sprintf("%02d:%02d-%02d:%02d", opensAt/60, opensAt%60, closesAt/60, closesAt%60)
+}
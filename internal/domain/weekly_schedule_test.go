@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpeningHour_WeekRanges(t *testing.T) {
+	tests := []struct {
+		name     string
+		hour     OpeningHour
+		expected []WeekRange
+	}{
+		{"same day", OpeningHour{Weekday: 1, OpensAt: 480, ClosesAt: 1200}, []WeekRange{{Start: 1920, End: 2640}}},
+		{"overnight spills into next day", OpeningHour{Weekday: 5, OpensAt: 1320, ClosesAt: 120}, []WeekRange{{Start: 8520, End: 8760}}},
+		{"saturday overnight wraps to sunday", OpeningHour{Weekday: 6, OpensAt: 1320, ClosesAt: 120}, []WeekRange{{Start: 9960, End: MinutesPerWeek}, {Start: 0, End: 120}}},
+		{"empty interval", OpeningHour{Weekday: 2, OpensAt: 600, ClosesAt: 600}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Output
+			ranges := tt.hour.WeekRanges()
+
+			// Assert
+			assert.Equal(t, tt.expected, ranges)
+		})
+	}
+}
+
+func TestNewWeeklySchedule_MergesContiguousAndOverlapping(t *testing.T) {
+	// Input
+	hours := []OpeningHour{
+		{Weekday: 5, OpensAt: 1080, ClosesAt: 0},  // sexta 18:00–00:00
+		{Weekday: 6, OpensAt: 0, ClosesAt: 120},   // sábado 00:00–02:00
+		{Weekday: 1, OpensAt: 600, ClosesAt: 900}, // segunda 10:00–15:00
+		{Weekday: 1, OpensAt: 840, ClosesAt: 960}, // segunda 14:00–16:00
+	}
+
+	// Output
+	schedule := NewWeeklySchedule(hours)
+
+	// Assert
+	assert.Equal(t, WeeklySchedule{
+		{Start: 1*MinutesPerDay + 600, End: 1*MinutesPerDay + 960},
+		{Start: 5*MinutesPerDay + 1080, End: 6*MinutesPerDay + 120},
+	}, schedule)
+}
+
+func TestWeeklySchedule_Contains_WrapsAroundWeek(t *testing.T) {
+	// Input: sábado 22:00 até domingo 02:00
+	schedule := NewWeeklySchedule([]OpeningHour{{Weekday: 6, OpensAt: 1320, ClosesAt: 120}})
+
+	// Output
+	saturdayNight := schedule.Contains(6*MinutesPerDay + 1380)
+	sundayEarly := schedule.Contains(60)
+	sundayMorning := schedule.Contains(180)
+	nextWeek := schedule.Contains(MinutesPerWeek + 60)
+
+	// Assert
+	assert.True(t, saturdayNight)
+	assert.True(t, sundayEarly)
+	assert.False(t, sundayMorning)
+	assert.True(t, nextWeek)
+}
+
+func TestValidateOpeningHours(t *testing.T) {
+	tests := []struct {
+		name  string
+		hours []OpeningHour
+		code  string
+	}{
+		{"two overnight intervals on different days", []OpeningHour{
+			{Weekday: 5, OpensAt: 1320, ClosesAt: 120},
+			{Weekday: 6, OpensAt: 1320, ClosesAt: 120},
+		}, ""},
+		{"overnight followed by later opening", []OpeningHour{
+			{Weekday: 5, OpensAt: 1320, ClosesAt: 120},
+			{Weekday: 6, OpensAt: 600, ClosesAt: 900},
+		}, ""},
+		{"overnight spill collides with next day", []OpeningHour{
+			{Weekday: 5, OpensAt: 1320, ClosesAt: 120},
+			{Weekday: 6, OpensAt: 60, ClosesAt: 300},
+		}, "opening_hours_overlap"},
+		{"saturday spill collides with sunday", []OpeningHour{
+			{Weekday: 6, OpensAt: 1320, ClosesAt: 180},
+			{Weekday: 0, OpensAt: 120, ClosesAt: 600},
+		}, "opening_hours_overlap"},
+		{"same day overlap", []OpeningHour{
+			{Weekday: 1, OpensAt: 480, ClosesAt: 720},
+			{Weekday: 1, OpensAt: 700, ClosesAt: 900},
+		}, "opening_hours_overlap"},
+		{"invalid weekday", []OpeningHour{{Weekday: 7, OpensAt: 0, ClosesAt: 60}}, "invalid_weekday"},
+		{"empty interval", []OpeningHour{{Weekday: 1, OpensAt: 600, ClosesAt: 600}}, "empty_interval"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Output
+			err := ValidateOpeningHours(tt.hours)
+
+			// Assert
+			if tt.code == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, NewValidationError(tt.code, ""))
+		})
+	}
+}
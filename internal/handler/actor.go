@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"gastro-go/internal/domain"
+)
+
+const (
+	actorContextKey = "actor"
+	headerActorID   = "X-Actor-ID"
+)
+
+// ActorMiddleware identifica o autor de cada requisição
+// Um Bearer token igual a adminToken identifica um administrador; as demais requisições
+// são tratadas como do lojista, identificado (opcionalmente) pelo cabeçalho X-Actor-ID.
+// Com adminToken vazio, nenhuma requisição é tratada como admin
+func ActorMiddleware(adminToken string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			actor := domain.Actor{
+				ID:   c.Request().Header.Get(headerActorID),
+				Role: domain.RoleMerchant,
+			}
+
+			token, ok := strings.CutPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+			if ok && adminToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) == 1 {
+				actor.Role = domain.RoleAdmin
+				if actor.ID == "" {
+					actor.ID = domain.RoleAdmin
+				}
+			}
+
+			c.Set(actorContextKey, actor)
+			return next(c)
+		}
+	}
+}
+
+// actorFrom retorna o ator identificado pelo ActorMiddleware
+func actorFrom(c echo.Context) domain.Actor {
+	actor, ok := c.Get(actorContextKey).(domain.Actor)
+	if !ok {
+		return domain.Actor{Role: domain.RoleMerchant}
+	}
+	return actor
+}
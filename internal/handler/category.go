@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"gastro-go/internal/usecase"
+)
+
+// CategoryHandler gerencia os endpoints do catálogo de categorias
+type CategoryHandler struct {
+	listUseCase   *usecase.ListCategoriesUseCase
+	createUseCase *usecase.CreateCategoryUseCase
+	updateUseCase *usecase.UpdateCategoryUseCase
+	deleteUseCase *usecase.DeleteCategoryUseCase
+}
+
+// NewCategoryHandler cria uma nova instância do handler
+func NewCategoryHandler(
+	listUseCase *usecase.ListCategoriesUseCase,
+	createUseCase *usecase.CreateCategoryUseCase,
+	updateUseCase *usecase.UpdateCategoryUseCase,
+	deleteUseCase *usecase.DeleteCategoryUseCase,
+) *CategoryHandler {
+	return &CategoryHandler{
+		listUseCase:   listUseCase,
+		createUseCase: createUseCase,
+		updateUseCase: updateUseCase,
+		deleteUseCase: deleteUseCase,
+	}
+}
+
+// SaveCategoryRequest representa o payload de criação/atualização de categoria
+type SaveCategoryRequest struct {
+	Name     string `json:"name"`
+	Slug     string `json:"slug,omitempty"`
+	Icon     string `json:"icon,omitempty"`
+	Position int    `json:"position"`
+}
+
+// ListCategories lista as categorias na ordem de exibição
+// GET /categories
+func (h *CategoryHandler) ListCategories(c echo.Context) error {
+	categories, err := h.listUseCase.Execute(c.Request().Context())
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, categories)
+}
+
+// CreateCategory cria uma categoria (somente admin)
+// POST /categories
+func (h *CategoryHandler) CreateCategory(c echo.Context) error {
+	var req SaveCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRequestBody, "invalid request body")
+	}
+
+	input := usecase.CreateCategoryInput{
+		Actor:    actorFrom(c),
+		Name:     req.Name,
+		Slug:     req.Slug,
+		Icon:     req.Icon,
+		Position: req.Position,
+	}
+
+	category, err := h.createUseCase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, category)
+}
+
+// UpdateCategory atualiza uma categoria (somente admin)
+// PUT /categories/{id}
+func (h *CategoryHandler) UpdateCategory(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidCategoryID, "invalid category id")
+	}
+
+	var req SaveCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRequestBody, "invalid request body")
+	}
+
+	input := usecase.UpdateCategoryInput{
+		ID:       id,
+		Actor:    actorFrom(c),
+		Name:     req.Name,
+		Slug:     req.Slug,
+		Icon:     req.Icon,
+		Position: req.Position,
+	}
+
+	category, err := h.updateUseCase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, category)
+}
+
+// DeleteCategory remove uma categoria sem restaurantes (somente admin)
+// DELETE /categories/{id}
+func (h *CategoryHandler) DeleteCategory(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidCategoryID, "invalid category id")
+	}
+
+	input := usecase.DeleteCategoryInput{
+		ID:    id,
+		Actor: actorFrom(c),
+	}
+
+	if err := h.deleteUseCase.Execute(c.Request().Context(), input); err != nil {
+		return writeError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
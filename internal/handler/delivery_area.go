@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"gastro-go/internal/domain"
+	"gastro-go/internal/usecase"
+)
+
+// DeliveryAreaHandler gerencia os endpoints de áreas de entrega
+type DeliveryAreaHandler struct {
+	updateUseCase *usecase.UpdateDeliveryAreasUseCase
+	checkUseCase  *usecase.CheckDeliveryUseCase
+}
+
+// NewDeliveryAreaHandler cria uma nova instância do handler
+func NewDeliveryAreaHandler(
+	updateUseCase *usecase.UpdateDeliveryAreasUseCase,
+	checkUseCase *usecase.CheckDeliveryUseCase,
+) *DeliveryAreaHandler {
+	return &DeliveryAreaHandler{
+		updateUseCase: updateUseCase,
+		checkUseCase:  checkUseCase,
+	}
+}
+
+// UpdateDeliveryAreasRequest representa o payload de atualização de áreas de entrega
+type UpdateDeliveryAreasRequest struct {
+	Areas []DeliveryAreaRequest `json:"areas"`
+}
+
+// DeliveryAreaRequest representa uma área de entrega
+// kind=RADIUS usa radius_m ao redor do endereço; kind=POLYGON usa um Polygon GeoJSON ([lng, lat])
+type DeliveryAreaRequest struct {
+	Kind         string                 `json:"kind"`
+	RadiusMeters int                    `json:"radius_m,omitempty"`
+	Polygon      *domain.GeoJSONPolygon `json:"polygon,omitempty"`
+}
+
+// DeliveryCheckResponse representa o resultado de GET /restaurants/{slug}/delivers-to
+type DeliveryCheckResponse struct {
+	RestaurantID   uuid.UUID `json:"restaurant_id"`
+	Delivers       bool      `json:"delivers"`
+	DistanceMeters *float64  `json:"distance_m,omitempty"`
+}
+
+// UpdateDeliveryAreas substitui as áreas de entrega do restaurante
+// PUT /restaurants/{id}/delivery-areas
+func (h *DeliveryAreaHandler) UpdateDeliveryAreas(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
+	}
+
+	var req UpdateDeliveryAreasRequest
+	if err := c.Bind(&req); err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRequestBody, "invalid request body")
+	}
+
+	areas := make([]usecase.DeliveryAreaInput, 0, len(req.Areas))
+	for _, area := range req.Areas {
+		areas = append(areas, usecase.DeliveryAreaInput{
+			Kind:         area.Kind,
+			RadiusMeters: area.RadiusMeters,
+			Polygon:      area.Polygon,
+		})
+	}
+
+	input := usecase.UpdateDeliveryAreasInput{
+		RestaurantID: id,
+		Areas:        areas,
+	}
+
+	saved, err := h.updateUseCase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, saved)
+}
+
+// CheckDelivery verifica se o restaurante entrega no ponto informado
+// GET /restaurants/{slug}/delivers-to?lat=-23.56&lng=-46.65
+func (h *DeliveryAreaHandler) CheckDelivery(c echo.Context) error {
+	lat, err := queryFloat64(c, "lat")
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidParameter, err.Error())
+	}
+	lng, err := queryFloat64(c, "lng")
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidParameter, err.Error())
+	}
+	if lat == nil || lng == nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidParameter, "lat and lng are required")
+	}
+
+	input := usecase.CheckDeliveryInput{
+		Slug:  c.Param("slug"),
+		Point: domain.GeoPoint{Lat: *lat, Lng: *lng},
+	}
+
+	output, err := h.checkUseCase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	// Slug aposentado: redireciona permanentemente para a mesma verificação no slug canônico
+	if output.CanonicalSlug != "" {
+		location := "/restaurants/" + url.PathEscape(output.CanonicalSlug) + "/delivers-to"
+		if query := c.QueryString(); query != "" {
+			location += "?" + query
+		}
+		return c.Redirect(http.StatusMovedPermanently, location)
+	}
+
+	return c.JSON(http.StatusOK, DeliveryCheckResponse{
+		RestaurantID:   output.RestaurantID,
+		Delivers:       output.Delivers,
+		DistanceMeters: output.DistanceMeters,
+	})
+}
@@ -0,0 +1,440 @@
+package handler
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"gastro-go/internal/usecase"
+)
+
+// MenuHandler gerencia os endpoints do cardápio
+type MenuHandler struct {
+	getMenuUseCase       *usecase.GetMenuUseCase
+	createSectionUseCase *usecase.CreateMenuSectionUseCase
+	updateSectionUseCase *usecase.UpdateMenuSectionUseCase
+	deleteSectionUseCase *usecase.DeleteMenuSectionUseCase
+	createItemUseCase    *usecase.CreateMenuItemUseCase
+	updateItemUseCase    *usecase.UpdateMenuItemUseCase
+	deleteItemUseCase    *usecase.DeleteMenuItemUseCase
+	updateOptionsUseCase *usecase.UpdateMenuOptionGroupsUseCase
+	sectionHoursUseCase  *usecase.UpdateMenuSectionAvailabilityUseCase
+	itemHoursUseCase     *usecase.UpdateMenuItemAvailabilityUseCase
+}
+
+// NewMenuHandler cria uma nova instância do handler
+func NewMenuHandler(
+	getMenuUseCase *usecase.GetMenuUseCase,
+	createSectionUseCase *usecase.CreateMenuSectionUseCase,
+	updateSectionUseCase *usecase.UpdateMenuSectionUseCase,
+	deleteSectionUseCase *usecase.DeleteMenuSectionUseCase,
+	createItemUseCase *usecase.CreateMenuItemUseCase,
+	updateItemUseCase *usecase.UpdateMenuItemUseCase,
+	deleteItemUseCase *usecase.DeleteMenuItemUseCase,
+	updateOptionsUseCase *usecase.UpdateMenuOptionGroupsUseCase,
+	sectionHoursUseCase *usecase.UpdateMenuSectionAvailabilityUseCase,
+	itemHoursUseCase *usecase.UpdateMenuItemAvailabilityUseCase,
+) *MenuHandler {
+	return &MenuHandler{
+		getMenuUseCase:       getMenuUseCase,
+		createSectionUseCase: createSectionUseCase,
+		updateSectionUseCase: updateSectionUseCase,
+		deleteSectionUseCase: deleteSectionUseCase,
+		createItemUseCase:    createItemUseCase,
+		updateItemUseCase:    updateItemUseCase,
+		deleteItemUseCase:    deleteItemUseCase,
+		updateOptionsUseCase: updateOptionsUseCase,
+		sectionHoursUseCase:  sectionHoursUseCase,
+		itemHoursUseCase:     itemHoursUseCase,
+	}
+}
+
+// SaveMenuSectionRequest representa o payload de criação/atualização de seção
+// active ausente equivale a true
+type SaveMenuSectionRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Position    int    `json:"position"`
+	Active      *bool  `json:"active,omitempty"`
+}
+
+// SaveMenuItemRequest representa o payload de criação/atualização de item
+// section_id só é lido na atualização (permite mover o item); active ausente equivale a true
+type SaveMenuItemRequest struct {
+	SectionID   uuid.UUID `json:"section_id,omitempty"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Price       int64     `json:"price"` // Em centavos
+	ImageURL    string    `json:"image_url,omitempty"`
+	Position    int       `json:"position"`
+	Active      *bool     `json:"active,omitempty"`
+}
+
+// UpdateMenuOptionGroupsRequest representa o payload de atualização dos grupos de opções de um item
+type UpdateMenuOptionGroupsRequest struct {
+	Groups []MenuOptionGroupRequest `json:"groups"`
+}
+
+// MenuOptionGroupRequest representa um grupo de opções; sem id, um novo grupo é criado
+// Grupo com required=false pode ficar sem escolha; se escolhido, vale min_selections..max_selections
+type MenuOptionGroupRequest struct {
+	ID            uuid.UUID           `json:"id"`
+	Name          string              `json:"name"`
+	MinSelections int                 `json:"min_selections"`
+	MaxSelections int                 `json:"max_selections"`
+	Required      bool                `json:"required"`
+	Position      int                 `json:"position"`
+	Options       []MenuOptionRequest `json:"options"`
+}
+
+// MenuOptionRequest representa uma opção do grupo; sem id, uma nova opção é criada
+// active ausente equivale a true
+type MenuOptionRequest struct {
+	ID         uuid.UUID `json:"id"`
+	Name       string    `json:"name"`
+	PriceDelta int64     `json:"price_delta"` // Em centavos, pode ser negativo
+	Position   int       `json:"position"`
+	Active     *bool     `json:"active,omitempty"`
+}
+
+// toInput converte o payload nos dados editáveis da seção
+func (r SaveMenuSectionRequest) toInput() usecase.MenuSectionInput {
+	return usecase.MenuSectionInput{
+		Name:        r.Name,
+		Description: r.Description,
+		Position:    r.Position,
+		Active:      r.Active == nil || *r.Active,
+	}
+}
+
+// toInput converte o payload nos dados editáveis do item
+func (r SaveMenuItemRequest) toInput() usecase.MenuItemInput {
+	return usecase.MenuItemInput{
+		Name:        r.Name,
+		Description: r.Description,
+		Price:       r.Price,
+		ImageURL:    r.ImageURL,
+		Position:    r.Position,
+		Active:      r.Active == nil || *r.Active,
+	}
+}
+
+// GetMenu retorna o cardápio público (seções e itens ativos, ordenados)
+// available é avaliado agora ou no instante informado em at (RFC 3339)
+// GET /restaurants/{slug}/menu?at=2025-06-02T08:30:00-03:00; slugs aposentados respondem 301
+func (h *MenuHandler) GetMenu(c echo.Context) error {
+	input := usecase.GetMenuInput{Slug: c.Param("slug")}
+	if raw := c.QueryParam("at"); raw != "" {
+		at, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return writeProblem(c, http.StatusBadRequest, codeInvalidParameter, "invalid at parameter")
+		}
+		input.At = at
+	}
+
+	output, err := h.getMenuUseCase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	// Slug aposentado: redireciona permanentemente para o cardápio no slug canônico
+	if output.CanonicalSlug != "" {
+		location := "/restaurants/" + url.PathEscape(output.CanonicalSlug) + "/menu"
+		if query := c.QueryString(); query != "" {
+			location += "?" + query
+		}
+		return c.Redirect(http.StatusMovedPermanently, location)
+	}
+
+	return c.JSON(http.StatusOK, output.Menu)
+}
+
+// CreateMenuSection cria uma seção do cardápio
+// POST /restaurants/{id}/menu/sections
+func (h *MenuHandler) CreateMenuSection(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
+	}
+
+	var req SaveMenuSectionRequest
+	if err := c.Bind(&req); err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRequestBody, "invalid request body")
+	}
+
+	input := usecase.CreateMenuSectionInput{
+		RestaurantID: id,
+		Section:      req.toInput(),
+	}
+
+	section, err := h.createSectionUseCase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, section)
+}
+
+// UpdateMenuSection substitui os dados de uma seção do cardápio
+// PUT /restaurants/{id}/menu/sections/{section_id}
+func (h *MenuHandler) UpdateMenuSection(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
+	}
+	sectionID, err := uuid.Parse(c.Param("section_id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidMenuSectionID, "invalid menu section id")
+	}
+
+	var req SaveMenuSectionRequest
+	if err := c.Bind(&req); err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRequestBody, "invalid request body")
+	}
+
+	input := usecase.UpdateMenuSectionInput{
+		RestaurantID: id,
+		SectionID:    sectionID,
+		Section:      req.toInput(),
+	}
+
+	section, err := h.updateSectionUseCase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, section)
+}
+
+// DeleteMenuSection remove uma seção do cardápio e os seus itens
+// DELETE /restaurants/{id}/menu/sections/{section_id}
+func (h *MenuHandler) DeleteMenuSection(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
+	}
+	sectionID, err := uuid.Parse(c.Param("section_id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidMenuSectionID, "invalid menu section id")
+	}
+
+	input := usecase.DeleteMenuSectionInput{
+		RestaurantID: id,
+		SectionID:    sectionID,
+	}
+
+	if err := h.deleteSectionUseCase.Execute(c.Request().Context(), input); err != nil {
+		return writeError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// CreateMenuItem cria um item em uma seção do cardápio
+// POST /restaurants/{id}/menu/sections/{section_id}/items
+func (h *MenuHandler) CreateMenuItem(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
+	}
+	sectionID, err := uuid.Parse(c.Param("section_id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidMenuSectionID, "invalid menu section id")
+	}
+
+	var req SaveMenuItemRequest
+	if err := c.Bind(&req); err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRequestBody, "invalid request body")
+	}
+
+	input := usecase.CreateMenuItemInput{
+		RestaurantID: id,
+		SectionID:    sectionID,
+		Item:         req.toInput(),
+	}
+
+	item, err := h.createItemUseCase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, item)
+}
+
+// UpdateMenuItem substitui os dados de um item do cardápio
+// PUT /restaurants/{id}/menu/items/{item_id}
+func (h *MenuHandler) UpdateMenuItem(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
+	}
+	itemID, err := uuid.Parse(c.Param("item_id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidMenuItemID, "invalid menu item id")
+	}
+
+	var req SaveMenuItemRequest
+	if err := c.Bind(&req); err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRequestBody, "invalid request body")
+	}
+	if req.SectionID == uuid.Nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidMenuSectionID, "section_id is required")
+	}
+
+	input := usecase.UpdateMenuItemInput{
+		RestaurantID: id,
+		ItemID:       itemID,
+		SectionID:    req.SectionID,
+		Item:         req.toInput(),
+	}
+
+	item, err := h.updateItemUseCase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, item)
+}
+
+// DeleteMenuItem remove um item do cardápio
+// DELETE /restaurants/{id}/menu/items/{item_id}
+func (h *MenuHandler) DeleteMenuItem(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
+	}
+	itemID, err := uuid.Parse(c.Param("item_id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidMenuItemID, "invalid menu item id")
+	}
+
+	input := usecase.DeleteMenuItemInput{
+		RestaurantID: id,
+		ItemID:       itemID,
+	}
+
+	if err := h.deleteItemUseCase.Execute(c.Request().Context(), input); err != nil {
+		return writeError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// UpdateMenuOptionGroups sincroniza os grupos de opções de um item do cardápio
+// PUT /restaurants/{id}/menu/items/{item_id}/option-groups
+func (h *MenuHandler) UpdateMenuOptionGroups(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
+	}
+	itemID, err := uuid.Parse(c.Param("item_id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidMenuItemID, "invalid menu item id")
+	}
+
+	var req UpdateMenuOptionGroupsRequest
+	if err := c.Bind(&req); err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRequestBody, "invalid request body")
+	}
+
+	groups := make([]usecase.MenuOptionGroupInput, 0, len(req.Groups))
+	for _, group := range req.Groups {
+		options := make([]usecase.MenuOptionInput, 0, len(group.Options))
+		for _, option := range group.Options {
+			options = append(options, usecase.MenuOptionInput{
+				ID:         option.ID,
+				Name:       option.Name,
+				PriceDelta: option.PriceDelta,
+				Position:   option.Position,
+				Active:     option.Active == nil || *option.Active,
+			})
+		}
+		groups = append(groups, usecase.MenuOptionGroupInput{
+			ID:            group.ID,
+			Name:          group.Name,
+			MinSelections: group.MinSelections,
+			MaxSelections: group.MaxSelections,
+			Required:      group.Required,
+			Position:      group.Position,
+			Options:       options,
+		})
+	}
+
+	input := usecase.UpdateMenuOptionGroupsInput{
+		RestaurantID: id,
+		ItemID:       itemID,
+		Groups:       groups,
+	}
+
+	saved, err := h.updateOptionsUseCase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, saved)
+}
+
+// UpdateMenuSectionAvailability substitui as janelas de venda de uma seção do cardápio
+// PUT /restaurants/{id}/menu/sections/{section_id}/availability
+func (h *MenuHandler) UpdateMenuSectionAvailability(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
+	}
+	sectionID, err := uuid.Parse(c.Param("section_id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidMenuSectionID, "invalid menu section id")
+	}
+
+	var req UpdateOpeningHoursRequest
+	if err := c.Bind(&req); err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRequestBody, "invalid request body")
+	}
+
+	input := usecase.UpdateMenuSectionAvailabilityInput{
+		RestaurantID: id,
+		SectionID:    sectionID,
+		Windows:      req.toInput(),
+	}
+
+	windows, err := h.sectionHoursUseCase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, windows)
+}
+
+// UpdateMenuItemAvailability substitui as janelas de venda de um item do cardápio
+// PUT /restaurants/{id}/menu/items/{item_id}/availability
+func (h *MenuHandler) UpdateMenuItemAvailability(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
+	}
+	itemID, err := uuid.Parse(c.Param("item_id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidMenuItemID, "invalid menu item id")
+	}
+
+	var req UpdateOpeningHoursRequest
+	if err := c.Bind(&req); err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRequestBody, "invalid request body")
+	}
+
+	input := usecase.UpdateMenuItemAvailabilityInput{
+		RestaurantID: id,
+		ItemID:       itemID,
+		Windows:      req.toInput(),
+	}
+
+	windows, err := h.itemHoursUseCase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, windows)
+}
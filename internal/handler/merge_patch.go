@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MIMEApplicationMergePatchJSON é o content type de JSON Merge Patch (RFC 7396)
+const MIMEApplicationMergePatchJSON = "application/merge-patch+json"
+
+// Cabeçalhos de concorrência otimista
+const (
+	headerETag    = "ETag"
+	headerIfMatch = "If-Match"
+)
+
+// mergePatch é um documento JSON Merge Patch já separado por campo
+type mergePatch map[string]json.RawMessage
+
+// patchField lê um campo do patch
+// Retorna nil se o campo estiver ausente. Para campos anuláveis, null vira o valor zero de T;
+// para os demais, null é rejeitado
+func patchField[T any](patch mergePatch, key string, nullable bool) (*T, error) {
+	raw, ok := patch[key]
+	if !ok {
+		return nil, nil
+	}
+	delete(patch, key)
+
+	var value T
+	if string(raw) == "null" {
+		if !nullable {
+			return nil, fmt.Errorf("%s cannot be null", key)
+		}
+		return &value, nil
+	}
+
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, fmt.Errorf("invalid value for %s", key)
+	}
+	return &value, nil
+}
+
+// rejectUnknownFields falha se restarem campos não consumidos no patch
+// (campos desconhecidos ou somente leitura, como rating e status)
+func rejectUnknownFields(patch mergePatch) error {
+	for key := range patch {
+		return fmt.Errorf("field %s cannot be patched", key)
+	}
+	return nil
+}
+
+// formatETag formata a versão de um recurso como ETag forte
+func formatETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// parseIfMatch extrai a versão esperada de um cabeçalho If-Match
+// Retorna nil para "*" (qualquer versão)
+func parseIfMatch(header string) (*int, error) {
+	header = strings.TrimSpace(header)
+	if header == "*" {
+		return nil, nil
+	}
+
+	header = strings.TrimPrefix(header, "W/")
+	unquoted, err := strconv.Unquote(header)
+	if err != nil {
+		return nil, fmt.Errorf("invalid If-Match header")
+	}
+
+	version, err := strconv.Atoi(unquoted)
+	if err != nil {
+		return nil, fmt.Errorf("invalid If-Match header")
+	}
+	return &version, nil
+}
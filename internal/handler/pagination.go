@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// PageInfo descreve a página retornada em respostas paginadas
+type PageInfo struct {
+	Limit      int32  `json:"limit"`
+	Offset     int32  `json:"offset"`
+	Total      *int64 `json:"total,omitempty"`
+	NextCursor string `json:"next_cursor,omitempty"` // Ausente na última página
+}
+
+// PageResponse é o envelope padrão de respostas paginadas
+type PageResponse[T any] struct {
+	Data []T      `json:"data"`
+	Page PageInfo `json:"page"`
+}
+
+// parseLimitOffset lê os parâmetros limit e offset da query string
+// Parâmetros ausentes retornam 0 (o use case aplica os valores padrão)
+func parseLimitOffset(c echo.Context) (limit, offset int32, err error) {
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		l, err := strconv.ParseInt(limitStr, 10, 32)
+		if err != nil {
+			return 0, 0, errors.New("invalid limit parameter")
+		}
+		limit = int32(l)
+	}
+
+	if offsetStr := c.QueryParam("offset"); offsetStr != "" {
+		o, err := strconv.ParseInt(offsetStr, 10, 32)
+		if err != nil {
+			return 0, 0, errors.New("invalid offset parameter")
+		}
+		offset = int32(o)
+	}
+
+	return limit, offset, nil
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gastro-go/internal/domain"
+)
+
+// MIMEApplicationProblemJSON é o content type de respostas de erro (RFC 7807)
+const MIMEApplicationProblemJSON = "application/problem+json"
+
+// Problem representa o corpo de uma resposta de erro no formato RFC 7807
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	Code     string `json:"code"` // Código estável para tratamento pelo cliente
+}
+
+// Códigos de erro gerados na própria camada HTTP
+const (
+	codeInvalidRequestBody   = "invalid_request_body"
+	codeInvalidRestaurantID  = "invalid_restaurant_id"
+	codeInvalidCategoryID    = "invalid_category_id"
+	codeInvalidMenuSectionID = "invalid_menu_section_id"
+	codeInvalidMenuItemID    = "invalid_menu_item_id"
+	codeInvalidParameter     = "invalid_parameter"
+	codeInternalError        = "internal_error"
+)
+
+// writeProblem escreve uma resposta application/problem+json
+func writeProblem(c echo.Context, status int, code, detail string) error {
+	c.Response().Header().Set(echo.HeaderContentType, MIMEApplicationProblemJSON)
+	return c.JSON(status, Problem{
+		Type:     "about:blank",
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Instance: c.Request().URL.Path,
+		Code:     code,
+	})
+}
+
+// writeError traduz erros de domínio para a resposta HTTP apropriada
+// Erros sem classificação viram 500 sem expor detalhes internos
+func writeError(c echo.Context, err error) error {
+	var domainErr *domain.Error
+	if !errors.As(err, &domainErr) {
+		c.Logger().Error(err)
+		return writeProblem(c, http.StatusInternalServerError, codeInternalError, "internal server error")
+	}
+
+	return writeProblem(c, statusFor(err), domainErr.Code, domainErr.Message)
+}
+
+// statusFor mapeia a categoria do erro de domínio para o status HTTP
+func statusFor(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrValidation):
+		return http.StatusBadRequest
+	case errors.Is(err, domain.ErrPreconditionFailed):
+		return http.StatusPreconditionFailed
+	case errors.Is(err, domain.ErrForbidden):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -1,25 +1,32 @@
 package handler
 
 import (
-	"errors"
+	"encoding/json"
 	"net/http"
-	"strconv"
+	"net/url"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 
+	"gastro-go/internal/domain"
 	"gastro-go/internal/usecase"
 )
 
 // RestaurantHandler gerencia os endpoints HTTP relacionados a restaurantes
 type RestaurantHandler struct {
-	createUseCase           *usecase.CreateRestaurantUseCase
-	listUseCase             *usecase.ListRestaurantsUseCase
-	getBySlugUseCase        *usecase.GetRestaurantBySlugUseCase
-	openUseCase             *usecase.OpenRestaurantUseCase
-	closeUseCase            *usecase.CloseRestaurantUseCase
-	updateOpeningHoursUseCase *usecase.UpdateOpeningHoursUseCase
+	createUseCase               *usecase.CreateRestaurantUseCase
+	listUseCase                 *usecase.ListRestaurantsUseCase
+	getBySlugUseCase            *usecase.GetRestaurantBySlugUseCase
+	openUseCase                 *usecase.OpenRestaurantUseCase
+	closeUseCase                *usecase.CloseRestaurantUseCase
+	updateOpeningHoursUseCase   *usecase.UpdateOpeningHoursUseCase
 	updatePaymentMethodsUseCase *usecase.UpdatePaymentMethodsUseCase
+	updateProfileUseCase        *usecase.UpdateRestaurantProfileUseCase
+	saveAddressUseCase          *usecase.SaveRestaurantAddressUseCase
+	getAddressUseCase           *usecase.GetRestaurantAddressUseCase
+	suspendUseCase              *usecase.SuspendRestaurantUseCase
+	reinstateUseCase            *usecase.ReinstateRestaurantUseCase
+	statusHistoryUseCase        *usecase.ListStatusHistoryUseCase
 }
 
 // NewRestaurantHandler cria uma nova instância do handler
@@ -31,44 +38,71 @@ func NewRestaurantHandler(
 	closeUseCase *usecase.CloseRestaurantUseCase,
 	updateOpeningHoursUseCase *usecase.UpdateOpeningHoursUseCase,
 	updatePaymentMethodsUseCase *usecase.UpdatePaymentMethodsUseCase,
+	updateProfileUseCase *usecase.UpdateRestaurantProfileUseCase,
+	saveAddressUseCase *usecase.SaveRestaurantAddressUseCase,
+	getAddressUseCase *usecase.GetRestaurantAddressUseCase,
+	suspendUseCase *usecase.SuspendRestaurantUseCase,
+	reinstateUseCase *usecase.ReinstateRestaurantUseCase,
+	statusHistoryUseCase *usecase.ListStatusHistoryUseCase,
 ) *RestaurantHandler {
 	return &RestaurantHandler{
-		createUseCase:              createUseCase,
-		listUseCase:                listUseCase,
-		getBySlugUseCase:          getBySlugUseCase,
-		openUseCase:                openUseCase,
-		closeUseCase:               closeUseCase,
-		updateOpeningHoursUseCase:  updateOpeningHoursUseCase,
+		createUseCase:               createUseCase,
+		listUseCase:                 listUseCase,
+		getBySlugUseCase:            getBySlugUseCase,
+		openUseCase:                 openUseCase,
+		closeUseCase:                closeUseCase,
+		updateOpeningHoursUseCase:   updateOpeningHoursUseCase,
 		updatePaymentMethodsUseCase: updatePaymentMethodsUseCase,
+		updateProfileUseCase:        updateProfileUseCase,
+		saveAddressUseCase:          saveAddressUseCase,
+		getAddressUseCase:           getAddressUseCase,
+		suspendUseCase:              suspendUseCase,
+		reinstateUseCase:            reinstateUseCase,
+		statusHistoryUseCase:        statusHistoryUseCase,
 	}
 }
 
 // CreateRestaurantRequest representa o payload de criação de restaurante
 type CreateRestaurantRequest struct {
-	Name               string                  `json:"name"`
-	Slug               string                  `json:"slug,omitempty"`
-	Description        string                  `json:"description,omitempty"`
-	Category           string                  `json:"category,omitempty"`
-	DeliveryFee        int64                   `json:"delivery_fee"`
-	MinOrderValue      int64                   `json:"min_order_value"`
-	PreparationTimeMin int                     `json:"preparation_time_min"`
-	SupportsPickup     bool                    `json:"supports_pickup"`
-	SupportsDelivery   bool                    `json:"supports_delivery"`
-	LogoURL            string                  `json:"logo_url,omitempty"`
-	BannerURL          string                  `json:"banner_url,omitempty"`
-	Address            *CreateAddressRequest    `json:"address,omitempty"`
+	Name               string                `json:"name"`
+	Slug               string                `json:"slug,omitempty"`
+	Description        string                `json:"description,omitempty"`
+	Categories         []string              `json:"categories,omitempty"` // IDs ou slugs; a primeira é a principal
+	DeliveryFee        int64                 `json:"delivery_fee"`
+	MinOrderValue      int64                 `json:"min_order_value"`
+	PreparationTimeMin int                   `json:"preparation_time_min"`
+	SupportsPickup     bool                  `json:"supports_pickup"`
+	SupportsDelivery   bool                  `json:"supports_delivery"`
+	LogoURL            string                `json:"logo_url,omitempty"`
+	BannerURL          string                `json:"banner_url,omitempty"`
+	Timezone           string                `json:"timezone,omitempty"`
+	Address            *CreateAddressRequest `json:"address,omitempty"`
 }
 
 // CreateAddressRequest representa o payload de criação de endereço
 type CreateAddressRequest struct {
-	Street     string  `json:"street"`
-	Number     string  `json:"number"`
-	Complement string  `json:"complement,omitempty"`
-	City       string  `json:"city"`
-	State      string  `json:"state"`
-	ZipCode    string  `json:"zip_code"`
-	Lat        float64 `json:"lat"`
-	Lng        float64 `json:"lng"`
+	Street     string   `json:"street"`
+	Number     string   `json:"number"`
+	Complement string   `json:"complement,omitempty"`
+	City       string   `json:"city"`
+	State      string   `json:"state"`
+	ZipCode    string   `json:"zip_code"`
+	Lat        *float64 `json:"lat,omitempty"`
+	Lng        *float64 `json:"lng,omitempty"`
+}
+
+// toInput converte o payload de endereço para o input dos use cases
+func (r *CreateAddressRequest) toInput() usecase.CreateAddressInput {
+	return usecase.CreateAddressInput{
+		Street:     r.Street,
+		Number:     r.Number,
+		Complement: r.Complement,
+		City:       r.City,
+		State:      r.State,
+		ZipCode:    r.ZipCode,
+		Lat:        r.Lat,
+		Lng:        r.Lng,
+	}
 }
 
 // UpdateOpeningHoursRequest representa o payload de atualização de horários
@@ -83,6 +117,24 @@ type OpeningHourRequest struct {
 	ClosesAt int `json:"closes_at"`
 }
 
+// toInput converte o payload nos horários do use case
+func (r UpdateOpeningHoursRequest) toInput() []usecase.OpeningHourInput {
+	hours := make([]usecase.OpeningHourInput, 0, len(r.Hours))
+	for _, hour := range r.Hours {
+		hours = append(hours, usecase.OpeningHourInput{
+			Weekday:  hour.Weekday,
+			OpensAt:  hour.OpensAt,
+			ClosesAt: hour.ClosesAt,
+		})
+	}
+	return hours
+}
+
+// StatusReasonRequest representa o payload de suspensão/reativação
+type StatusReasonRequest struct {
+	Reason string `json:"reason"`
+}
+
 // UpdatePaymentMethodsRequest representa o payload de atualização de métodos de pagamento
 type UpdatePaymentMethodsRequest struct {
 	Methods []string `json:"methods"`
@@ -93,30 +145,20 @@ type UpdatePaymentMethodsRequest struct {
 func (h *RestaurantHandler) CreateRestaurant(c echo.Context) error {
 	var req CreateRestaurantRequest
 	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid request body",
-		})
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRequestBody, "invalid request body")
 	}
 
 	var addressInput *usecase.CreateAddressInput
 	if req.Address != nil {
-		addressInput = &usecase.CreateAddressInput{
-			Street:     req.Address.Street,
-			Number:     req.Address.Number,
-			Complement: req.Address.Complement,
-			City:       req.Address.City,
-			State:      req.Address.State,
-			ZipCode:    req.Address.ZipCode,
-			Lat:        req.Address.Lat,
-			Lng:        req.Address.Lng,
-		}
+		in := req.Address.toInput()
+		addressInput = &in
 	}
 
 	input := usecase.CreateRestaurantInput{
 		Name:               req.Name,
 		Slug:               req.Slug,
 		Description:        req.Description,
-		Category:           req.Category,
+		Categories:         req.Categories,
 		DeliveryFee:        req.DeliveryFee,
 		MinOrderValue:      req.MinOrderValue,
 		PreparationTimeMin: req.PreparationTimeMin,
@@ -124,90 +166,242 @@ func (h *RestaurantHandler) CreateRestaurant(c echo.Context) error {
 		SupportsDelivery:   req.SupportsDelivery,
 		LogoURL:            req.LogoURL,
 		BannerURL:          req.BannerURL,
+		Timezone:           req.Timezone,
 		Address:            addressInput,
 	}
 
 	restaurant, err := h.createUseCase.Execute(c.Request().Context(), input)
 	if err != nil {
-		return h.handleError(c, err)
+		return writeError(c, err)
 	}
 
 	return c.JSON(http.StatusCreated, restaurant)
 }
 
-// ListRestaurants lista restaurantes com paginação
-// GET /restaurants
+// ListRestaurants lista restaurantes com filtros e paginação por cursor ou offset
+// GET /restaurants?q=pizzaria+joao&category=Pizza&state=SP&open_now=true&sort=-rating&cursor=...&include_total=true
+// sort aceita rating, total_reviews, delivery_fee, min_order_value, preparation_time_min, name
+// e distance (exige lat/lng); "-" inverte a ordem
 func (h *RestaurantHandler) ListRestaurants(c echo.Context) error {
-	limitStr := c.QueryParam("limit")
-	offsetStr := c.QueryParam("offset")
+	limit, offset, err := parseLimitOffset(c)
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidParameter, err.Error())
+	}
 
-	limit := int32(20) // Default
-	offset := int32(0)
+	filter, err := parseRestaurantFilter(c)
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidParameter, err.Error())
+	}
 
-	if limitStr != "" {
-		l, err := strconv.ParseInt(limitStr, 10, 32)
-		if err != nil {
-			return c.JSON(http.StatusBadRequest, map[string]string{
-				"error": "invalid limit parameter",
-			})
-		}
-		limit = int32(l)
+	origin, err := parseListOrigin(c)
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidParameter, err.Error())
 	}
 
-	if offsetStr != "" {
-		o, err := strconv.ParseInt(offsetStr, 10, 32)
-		if err != nil {
-			return c.JSON(http.StatusBadRequest, map[string]string{
-				"error": "invalid offset parameter",
-			})
-		}
-		offset = int32(o)
+	includeTotal, err := queryBool(c, "include_total")
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidParameter, err.Error())
 	}
 
 	input := usecase.ListRestaurantsInput{
-		Limit:  limit,
-		Offset: offset,
+		Filter:       filter,
+		Sort:         c.QueryParam("sort"),
+		Origin:       origin,
+		Limit:        limit,
+		Offset:       offset,
+		Cursor:       c.QueryParam("cursor"),
+		IncludeTotal: includeTotal != nil && *includeTotal,
 	}
 
-	restaurants, err := h.listUseCase.Execute(c.Request().Context(), input)
+	output, err := h.listUseCase.Execute(c.Request().Context(), input)
 	if err != nil {
-		return h.handleError(c, err)
+		return writeError(c, err)
 	}
 
-	return c.JSON(http.StatusOK, restaurants)
+	return c.JSON(http.StatusOK, PageResponse[*domain.Restaurant]{
+		Data: output.Items,
+		Page: PageInfo{
+			Limit:      output.Limit,
+			Offset:     output.Offset,
+			Total:      output.Total,
+			NextCursor: output.NextCursor,
+		},
+	})
 }
 
 // GetRestaurantBySlug busca um restaurante por slug
-// GET /restaurants/{slug}
+// GET /restaurants/{slug}; slugs aposentados respondem 301 com Location para o slug atual
 func (h *RestaurantHandler) GetRestaurantBySlug(c echo.Context) error {
 	slug := c.Param("slug")
 	if slug == "" {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "slug is required",
-		})
+		return writeProblem(c, http.StatusBadRequest, codeInvalidParameter, "slug is required")
 	}
 
-	restaurant, err := h.getBySlugUseCase.Execute(c.Request().Context(), slug)
+	output, err := h.getBySlugUseCase.Execute(c.Request().Context(), slug)
 	if err != nil {
-		return h.handleError(c, err)
+		return writeError(c, err)
+	}
+
+	// Slug aposentado: redireciona permanentemente para o slug canônico
+	if output.CanonicalSlug != "" {
+		location := "/restaurants/" + url.PathEscape(output.CanonicalSlug)
+		if query := c.QueryString(); query != "" {
+			location += "?" + query
+		}
+		return c.Redirect(http.StatusMovedPermanently, location)
+	}
+
+	c.Response().Header().Set(headerETag, formatETag(output.Restaurant.Version))
+	return c.JSON(http.StatusOK, output.Restaurant)
+}
+
+// UpdateRestaurantProfile atualiza parcialmente o perfil de um restaurante
+// Corpo em JSON Merge Patch (RFC 7396); If-Match com o ETag protege contra escritas concorrentes
+// PATCH /restaurants/{id}
+func (h *RestaurantHandler) UpdateRestaurantProfile(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
+	}
+
+	// c.Bind não reconhece application/merge-patch+json e não distingue campo ausente de null
+	var patch mergePatch
+	if err := json.NewDecoder(c.Request().Body).Decode(&patch); err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRequestBody, "invalid request body")
+	}
+
+	input, err := profilePatchToInput(id, patch)
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRequestBody, err.Error())
+	}
+
+	if ifMatch := c.Request().Header.Get(headerIfMatch); ifMatch != "" {
+		input.ExpectedVersion, err = parseIfMatch(ifMatch)
+		if err != nil {
+			return writeProblem(c, http.StatusBadRequest, codeInvalidParameter, err.Error())
+		}
 	}
 
+	restaurant, err := h.updateProfileUseCase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	c.Response().Header().Set(headerETag, formatETag(restaurant.Version))
 	return c.JSON(http.StatusOK, restaurant)
 }
 
+// profilePatchToInput converte o merge patch nos campos opcionais do use case
+func profilePatchToInput(id uuid.UUID, patch mergePatch) (usecase.UpdateRestaurantProfileInput, error) {
+	input := usecase.UpdateRestaurantProfileInput{RestaurantID: id}
+
+	var err error
+	if input.Name, err = patchField[string](patch, "name", false); err != nil {
+		return input, err
+	}
+	if input.Slug, err = patchField[string](patch, "slug", false); err != nil {
+		return input, err
+	}
+	if input.Description, err = patchField[string](patch, "description", true); err != nil {
+		return input, err
+	}
+	// null remove todas as categorias
+	if input.Categories, err = patchField[[]string](patch, "categories", true); err != nil {
+		return input, err
+	}
+	if input.DeliveryFee, err = patchField[int64](patch, "delivery_fee", false); err != nil {
+		return input, err
+	}
+	if input.MinOrderValue, err = patchField[int64](patch, "min_order_value", false); err != nil {
+		return input, err
+	}
+	if input.PreparationTimeMin, err = patchField[int](patch, "preparation_time_min", false); err != nil {
+		return input, err
+	}
+	if input.SupportsPickup, err = patchField[bool](patch, "supports_pickup", false); err != nil {
+		return input, err
+	}
+	if input.SupportsDelivery, err = patchField[bool](patch, "supports_delivery", false); err != nil {
+		return input, err
+	}
+	if input.LogoURL, err = patchField[string](patch, "logo_url", true); err != nil {
+		return input, err
+	}
+	if input.BannerURL, err = patchField[string](patch, "banner_url", true); err != nil {
+		return input, err
+	}
+	// null remove o fuso explícito e volta a inferir pela UF do endereço
+	if input.Timezone, err = patchField[string](patch, "timezone", true); err != nil {
+		return input, err
+	}
+
+	return input, rejectUnknownFields(patch)
+}
+
+// SaveRestaurantAddress cria ou substitui o endereço de um restaurante
+// PUT /restaurants/{id}/address
+func (h *RestaurantHandler) SaveRestaurantAddress(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
+	}
+
+	var req CreateAddressRequest
+	if err := c.Bind(&req); err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRequestBody, "invalid request body")
+	}
+
+	input := usecase.SaveRestaurantAddressInput{
+		RestaurantID: id,
+		Address:      req.toInput(),
+	}
+
+	output, err := h.saveAddressUseCase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	if output.Created {
+		return c.JSON(http.StatusCreated, output.Address)
+	}
+	return c.JSON(http.StatusOK, output.Address)
+}
+
+// GetRestaurantAddress busca o endereço de um restaurante
+// GET /restaurants/{id}/address
+func (h *RestaurantHandler) GetRestaurantAddress(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
+	}
+
+	address, err := h.getAddressUseCase.Execute(c.Request().Context(), id)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, address)
+}
+
 // OpenRestaurant abre um restaurante
 // PATCH /restaurants/{id}/open
 func (h *RestaurantHandler) OpenRestaurant(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid restaurant id",
-		})
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
 	}
 
-	if err := h.openUseCase.Execute(c.Request().Context(), id); err != nil {
-		return h.handleError(c, err)
+	input := usecase.OpenRestaurantInput{
+		RestaurantID: id,
+		Actor:        actorFrom(c),
+	}
+
+	if err := h.openUseCase.Execute(c.Request().Context(), input); err != nil {
+		return writeError(c, err)
 	}
 
 	return c.JSON(http.StatusOK, map[string]string{
@@ -221,13 +415,16 @@ func (h *RestaurantHandler) CloseRestaurant(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid restaurant id",
-		})
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
+	}
+
+	input := usecase.CloseRestaurantInput{
+		RestaurantID: id,
+		Actor:        actorFrom(c),
 	}
 
-	if err := h.closeUseCase.Execute(c.Request().Context(), id); err != nil {
-		return h.handleError(c, err)
+	if err := h.closeUseCase.Execute(c.Request().Context(), input); err != nil {
+		return writeError(c, err)
 	}
 
 	return c.JSON(http.StatusOK, map[string]string{
@@ -235,40 +432,120 @@ func (h *RestaurantHandler) CloseRestaurant(c echo.Context) error {
 	})
 }
 
+// SuspendRestaurant suspende um restaurante (somente admin)
+// PATCH /restaurants/{id}/suspend
+func (h *RestaurantHandler) SuspendRestaurant(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
+	}
+
+	var req StatusReasonRequest
+	if err := c.Bind(&req); err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRequestBody, "invalid request body")
+	}
+
+	input := usecase.SuspendRestaurantInput{
+		RestaurantID: id,
+		Actor:        actorFrom(c),
+		Reason:       req.Reason,
+	}
+
+	if err := h.suspendUseCase.Execute(c.Request().Context(), input); err != nil {
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "restaurant suspended successfully",
+	})
+}
+
+// ReinstateRestaurant reativa um restaurante suspenso (somente admin)
+// PATCH /restaurants/{id}/reinstate
+func (h *RestaurantHandler) ReinstateRestaurant(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
+	}
+
+	var req StatusReasonRequest
+	if err := c.Bind(&req); err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRequestBody, "invalid request body")
+	}
+
+	input := usecase.ReinstateRestaurantInput{
+		RestaurantID: id,
+		Actor:        actorFrom(c),
+		Reason:       req.Reason,
+	}
+
+	if err := h.reinstateUseCase.Execute(c.Request().Context(), input); err != nil {
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "restaurant reinstated successfully",
+	})
+}
+
+// ListStatusHistory lista a linha do tempo de mudanças de status
+// GET /restaurants/{id}/status-history
+func (h *RestaurantHandler) ListStatusHistory(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
+	}
+
+	limit, offset, err := parseLimitOffset(c)
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidParameter, err.Error())
+	}
+
+	input := usecase.ListStatusHistoryInput{
+		RestaurantID: id,
+		Limit:        limit,
+		Offset:       offset,
+	}
+
+	output, err := h.statusHistoryUseCase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, PageResponse[*domain.StatusChange]{
+		Data: output.Items,
+		Page: PageInfo{
+			Limit:  output.Limit,
+			Offset: output.Offset,
+			Total:  &output.Total,
+		},
+	})
+}
+
 // UpdateOpeningHours atualiza os horários de funcionamento
 // PUT /restaurants/{id}/hours
 func (h *RestaurantHandler) UpdateOpeningHours(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid restaurant id",
-		})
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
 	}
 
 	var req UpdateOpeningHoursRequest
 	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid request body",
-		})
-	}
-
-	hours := make([]usecase.OpeningHourInput, 0, len(req.Hours))
-	for _, h := range req.Hours {
-		hours = append(hours, usecase.OpeningHourInput{
-			Weekday:  h.Weekday,
-			OpensAt:  h.OpensAt,
-			ClosesAt: h.ClosesAt,
-		})
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRequestBody, "invalid request body")
 	}
 
 	input := usecase.UpdateOpeningHoursInput{
 		RestaurantID: id,
-		Hours:        hours,
+		Hours:        req.toInput(),
 	}
 
 	if err := h.updateOpeningHoursUseCase.Execute(c.Request().Context(), input); err != nil {
-		return h.handleError(c, err)
+		return writeError(c, err)
 	}
 
 	return c.JSON(http.StatusOK, map[string]string{
@@ -282,16 +559,12 @@ func (h *RestaurantHandler) UpdatePaymentMethods(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid restaurant id",
-		})
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
 	}
 
 	var req UpdatePaymentMethodsRequest
 	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid request body",
-		})
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRequestBody, "invalid request body")
 	}
 
 	input := usecase.UpdatePaymentMethodsInput{
@@ -300,49 +573,10 @@ func (h *RestaurantHandler) UpdatePaymentMethods(c echo.Context) error {
 	}
 
 	if err := h.updatePaymentMethodsUseCase.Execute(c.Request().Context(), input); err != nil {
-		return h.handleError(c, err)
+		return writeError(c, err)
 	}
 
 	return c.JSON(http.StatusOK, map[string]string{
 		"message": "payment methods updated successfully",
 	})
 }
-
-// handleError trata erros e retorna a resposta HTTP apropriada
-func (h *RestaurantHandler) handleError(c echo.Context, err error) error {
-	errMsg := err.Error()
-
-	// Verificar tipo de erro
-	if errors.Is(err, errors.New("conflict")) || errMsg == "create restaurant usecase: slug already exists: conflict" {
-		return c.JSON(http.StatusConflict, map[string]string{
-			"error": "slug already exists",
-		})
-	}
-
-	if errMsg == "restaurant repository: restaurant not found: no rows in result set" ||
-		errMsg == "get restaurant by slug usecase: restaurant repository: restaurant not found: no rows in result set" ||
-		errMsg == "open restaurant usecase: restaurant repository: restaurant not found: no rows in result set" ||
-		errMsg == "close restaurant usecase: restaurant repository: restaurant not found: no rows in result set" {
-		return c.JSON(http.StatusNotFound, map[string]string{
-			"error": "restaurant not found",
-		})
-	}
-
-	// Erros de validação (400)
-	if errMsg == "create restaurant usecase: name is required" ||
-		errMsg == "create restaurant usecase: delivery fee cannot be negative" ||
-		errMsg == "create restaurant usecase: min order value cannot be negative" ||
-		errMsg == "open restaurant usecase: restaurant must have an address to be opened" ||
-		errMsg == "open restaurant usecase: restaurant must have opening hours to be opened" ||
-		errMsg == "open restaurant usecase: restaurant must have at least one payment method to be opened" {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": errMsg,
-		})
-	}
-
-	// Erro genérico (500)
-	return c.JSON(http.StatusInternalServerError, map[string]string{
-		"error": "internal server error",
-	})
-}
-
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"gastro-go/internal/domain"
+)
+
+// parseRestaurantFilter lê os filtros da listagem de restaurantes da query string
+// Erros de formato viram 400; a validação dos valores fica no domínio
+func parseRestaurantFilter(c echo.Context) (domain.RestaurantFilter, error) {
+	filter := domain.RestaurantFilter{
+		Query:    c.QueryParam("q"),
+		Category: c.QueryParam("category"),
+		Status:   c.QueryParam("status"),
+		City:     c.QueryParam("city"),
+		State:    c.QueryParam("state"),
+	}
+
+	var err error
+	if filter.SupportsDelivery, err = queryBool(c, "supports_delivery"); err != nil {
+		return filter, err
+	}
+	if filter.SupportsPickup, err = queryBool(c, "supports_pickup"); err != nil {
+		return filter, err
+	}
+	if filter.MaxDeliveryFee, err = queryInt64(c, "max_delivery_fee"); err != nil {
+		return filter, err
+	}
+
+	maxPrep, err := queryInt64(c, "max_preparation_time")
+	if err != nil {
+		return filter, err
+	}
+	if maxPrep != nil {
+		v := int(*maxPrep)
+		filter.MaxPreparationTimeMin = &v
+	}
+
+	openNow, err := queryBool(c, "open_now")
+	if err != nil {
+		return filter, err
+	}
+	filter.OpenNow = openNow != nil && *openNow
+
+	// delivers_to_lat/delivers_to_lng: apenas restaurantes que entregam no ponto
+	deliversLat, err := queryFloat64(c, "delivers_to_lat")
+	if err != nil {
+		return filter, err
+	}
+	deliversLng, err := queryFloat64(c, "delivers_to_lng")
+	if err != nil {
+		return filter, err
+	}
+	if (deliversLat == nil) != (deliversLng == nil) {
+		return filter, errors.New("delivers_to_lat and delivers_to_lng must be informed together")
+	}
+	if deliversLat != nil {
+		filter.DeliversTo = &domain.GeoPoint{Lat: *deliversLat, Lng: *deliversLng}
+	}
+
+	return filter, nil
+}
+
+// parseListOrigin lê lat/lng da listagem, o ponto de referência usado em sort=distance
+func parseListOrigin(c echo.Context) (*domain.GeoPoint, error) {
+	lat, err := queryFloat64(c, "lat")
+	if err != nil {
+		return nil, err
+	}
+	lng, err := queryFloat64(c, "lng")
+	if err != nil {
+		return nil, err
+	}
+	if (lat == nil) != (lng == nil) {
+		return nil, errors.New("lat and lng must be informed together")
+	}
+	if lat == nil {
+		return nil, nil
+	}
+	return &domain.GeoPoint{Lat: *lat, Lng: *lng}, nil
+}
+
+// queryBool lê um parâmetro booleano opcional (true/false/1/0)
+func queryBool(c echo.Context, name string) (*bool, error) {
+	raw := c.QueryParam(name)
+	if raw == "" {
+		return nil, nil
+	}
+	value, err := strconv.ParseBool(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s parameter", name)
+	}
+	return &value, nil
+}
+
+// queryInt64 lê um parâmetro inteiro opcional
+func queryInt64(c echo.Context, name string) (*int64, error) {
+	raw := c.QueryParam(name)
+	if raw == "" {
+		return nil, nil
+	}
+	value, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s parameter", name)
+	}
+	return &value, nil
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"gastro-go/internal/domain"
+	"gastro-go/internal/usecase"
+)
+
+// SearchHandler gerencia os endpoints de descoberta de restaurantes
+type SearchHandler struct {
+	nearbyUseCase *usecase.ListNearbyRestaurantsUseCase
+	facetsUseCase *usecase.GetRestaurantFacetsUseCase
+}
+
+// NewSearchHandler cria uma nova instância do handler
+func NewSearchHandler(
+	nearbyUseCase *usecase.ListNearbyRestaurantsUseCase,
+	facetsUseCase *usecase.GetRestaurantFacetsUseCase,
+) *SearchHandler {
+	return &SearchHandler{
+		nearbyUseCase: nearbyUseCase,
+		facetsUseCase: facetsUseCase,
+	}
+}
+
+// ListNearbyRestaurants busca restaurantes ao redor de um ponto, do mais próximo ao mais distante
+// GET /restaurants/nearby?lat=-23.56&lng=-46.65&radius_km=5&open_now=true&limit=20
+func (h *SearchHandler) ListNearbyRestaurants(c echo.Context) error {
+	lat, err := queryFloat64(c, "lat")
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidParameter, err.Error())
+	}
+	lng, err := queryFloat64(c, "lng")
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidParameter, err.Error())
+	}
+	if lat == nil || lng == nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidParameter, "lat and lng are required")
+	}
+	radius, err := queryFloat64(c, "radius_km")
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidParameter, err.Error())
+	}
+	openNow, err := queryBool(c, "open_now")
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidParameter, err.Error())
+	}
+	limit, _, err := parseLimitOffset(c)
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidParameter, err.Error())
+	}
+
+	input := usecase.ListNearbyRestaurantsInput{
+		Search: domain.NearbySearch{
+			Lat:     *lat,
+			Lng:     *lng,
+			OpenNow: openNow != nil && *openNow,
+		},
+		Limit: limit,
+	}
+	if radius != nil {
+		input.Search.RadiusKm = *radius
+	}
+
+	output, err := h.nearbyUseCase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, PageResponse[*domain.Restaurant]{
+		Data: output.Items,
+		Page: PageInfo{Limit: output.Limit},
+	})
+}
+
+// GetRestaurantFacets retorna as contagens por categoria, cidade, método de pagamento e
+// aberto agora, sob os mesmos filtros de GET /restaurants
+// GET /restaurants/facets?state=SP&supports_delivery=true
+func (h *SearchHandler) GetRestaurantFacets(c echo.Context) error {
+	filter, err := parseRestaurantFilter(c)
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidParameter, err.Error())
+	}
+
+	facets, err := h.facetsUseCase.Execute(c.Request().Context(), filter)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, facets)
+}
+
+// queryFloat64 lê um parâmetro decimal opcional
+func queryFloat64(c echo.Context, name string) (*float64, error) {
+	raw := c.QueryParam(name)
+	if raw == "" {
+		return nil, nil
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s parameter", name)
+	}
+	return &value, nil
+}
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"gastro-go/internal/domain"
+	"gastro-go/internal/usecase"
+)
+
+// SpecialHoursHandler gerencia os endpoints de exceções de horário por data
+type SpecialHoursHandler struct {
+	saveUseCase   *usecase.SaveSpecialHoursUseCase
+	listUseCase   *usecase.ListSpecialHoursUseCase
+	deleteUseCase *usecase.DeleteSpecialHoursUseCase
+}
+
+// NewSpecialHoursHandler cria uma nova instância do handler
+func NewSpecialHoursHandler(
+	saveUseCase *usecase.SaveSpecialHoursUseCase,
+	listUseCase *usecase.ListSpecialHoursUseCase,
+	deleteUseCase *usecase.DeleteSpecialHoursUseCase,
+) *SpecialHoursHandler {
+	return &SpecialHoursHandler{
+		saveUseCase:   saveUseCase,
+		listUseCase:   listUseCase,
+		deleteUseCase: deleteUseCase,
+	}
+}
+
+// SaveSpecialHoursRequest representa o payload de uma exceção de horário
+// closed=true fecha o dia todo; caso contrário, intervals substitui os horários semanais da data
+type SaveSpecialHoursRequest struct {
+	Closed    bool                  `json:"closed"`
+	Intervals []TimeIntervalRequest `json:"intervals,omitempty"`
+	Note      string                `json:"note,omitempty"`
+}
+
+// TimeIntervalRequest representa um intervalo de funcionamento
+type TimeIntervalRequest struct {
+	OpensAt  int `json:"opens_at"`
+	ClosesAt int `json:"closes_at"`
+}
+
+// ListSpecialHours lista as exceções de horário de um restaurante
+// GET /restaurants/{id}/special-hours?from=2024-12-01&to=2024-12-31
+func (h *SpecialHoursHandler) ListSpecialHours(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
+	}
+
+	input := usecase.ListSpecialHoursInput{
+		RestaurantID: id,
+		From:         c.QueryParam("from"),
+		To:           c.QueryParam("to"),
+	}
+
+	specials, err := h.listUseCase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, specials)
+}
+
+// SaveSpecialHours cria ou substitui a exceção de horário de uma data
+// PUT /restaurants/{id}/special-hours/{date}
+func (h *SpecialHoursHandler) SaveSpecialHours(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
+	}
+
+	var req SaveSpecialHoursRequest
+	if err := c.Bind(&req); err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRequestBody, "invalid request body")
+	}
+
+	intervals := make([]domain.TimeInterval, 0, len(req.Intervals))
+	for _, interval := range req.Intervals {
+		intervals = append(intervals, domain.TimeInterval{
+			OpensAt:  interval.OpensAt,
+			ClosesAt: interval.ClosesAt,
+		})
+	}
+
+	input := usecase.SaveSpecialHoursInput{
+		RestaurantID: id,
+		Date:         c.Param("date"),
+		Closed:       req.Closed,
+		Intervals:    intervals,
+		Note:         req.Note,
+	}
+
+	special, err := h.saveUseCase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, special)
+}
+
+// DeleteSpecialHours remove a exceção de horário de uma data
+// DELETE /restaurants/{id}/special-hours/{date}
+func (h *SpecialHoursHandler) DeleteSpecialHours(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return writeProblem(c, http.StatusBadRequest, codeInvalidRestaurantID, "invalid restaurant id")
+	}
+
+	input := usecase.DeleteSpecialHoursInput{
+		RestaurantID: id,
+		Date:         c.Param("date"),
+	}
+
+	if err := h.deleteUseCase.Execute(c.Request().Context(), input); err != nil {
+		return writeError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
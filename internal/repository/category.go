@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"gastro-go/internal/database"
+	"gastro-go/internal/domain"
+)
+
+// CreateCategory cria uma categoria da taxonomia
+func (r *RestaurantRepository) CreateCategory(ctx context.Context, category *domain.Category) error {
+	dbCategory, err := r.q(ctx).CreateCategory(ctx, database.CreateCategoryParams{
+		Slug:     category.Slug,
+		Name:     category.Name,
+		Icon:     toText(category.Icon),
+		Position: int32(category.Position),
+	})
+	if err != nil {
+		if isUniqueViolation(err, constraintCategorySlug) {
+			return fmt.Errorf("restaurant repository: %w", domain.ErrCategorySlugAlreadyExists.Wrap(err))
+		}
+		return fmt.Errorf("restaurant repository: create category: %w", err)
+	}
+
+	*category = categoryToDomain(dbCategory)
+	return nil
+}
+
+// UpdateCategory atualiza slug, nome, ícone e posição de uma categoria
+func (r *RestaurantRepository) UpdateCategory(ctx context.Context, category *domain.Category) error {
+	dbCategory, err := r.q(ctx).UpdateCategory(ctx, database.UpdateCategoryParams{
+		ID:       category.ID,
+		Slug:     category.Slug,
+		Name:     category.Name,
+		Icon:     toText(category.Icon),
+		Position: int32(category.Position),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("restaurant repository: %w", domain.ErrCategoryNotFound.Wrap(err))
+		}
+		if isUniqueViolation(err, constraintCategorySlug) {
+			return fmt.Errorf("restaurant repository: %w", domain.ErrCategorySlugAlreadyExists.Wrap(err))
+		}
+		return fmt.Errorf("restaurant repository: update category: %w", err)
+	}
+
+	*category = categoryToDomain(dbCategory)
+	return nil
+}
+
+// DeleteCategory remove uma categoria sem restaurantes
+// A FK de restaurant_categories decide atomicamente se a categoria ainda está em uso
+func (r *RestaurantRepository) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	deleted, err := r.q(ctx).DeleteCategory(ctx, id)
+	if err != nil {
+		if isForeignKeyViolation(err, constraintRestaurantCategoryCategory) {
+			return fmt.Errorf("restaurant repository: %w", domain.ErrCategoryInUse.Wrap(err))
+		}
+		return fmt.Errorf("restaurant repository: delete category: %w", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("restaurant repository: %w", domain.ErrCategoryNotFound)
+	}
+	return nil
+}
+
+// GetCategory busca uma categoria por ID
+func (r *RestaurantRepository) GetCategory(ctx context.Context, id uuid.UUID) (*domain.Category, error) {
+	dbCategory, err := r.q(ctx).GetCategoryByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("restaurant repository: %w", domain.ErrCategoryNotFound.Wrap(err))
+		}
+		return nil, fmt.Errorf("restaurant repository: get category: %w", err)
+	}
+
+	category := categoryToDomain(dbCategory)
+	return &category, nil
+}
+
+// ListCategories lista a taxonomia na ordem de exibição
+func (r *RestaurantRepository) ListCategories(ctx context.Context) ([]domain.Category, error) {
+	dbCategories, err := r.q(ctx).ListCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: list categories: %w", err)
+	}
+
+	return categoriesToDomain(dbCategories), nil
+}
+
+// CategorySlugExists verifica se o slug pertence a outra categoria (uuid.Nil na criação)
+func (r *RestaurantRepository) CategorySlugExists(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error) {
+	exists, err := r.q(ctx).CategorySlugExists(ctx, database.CategorySlugExistsParams{Slug: slug, ID: excludeID})
+	if err != nil {
+		return false, fmt.Errorf("restaurant repository: check category slug exists: %w", err)
+	}
+	return exists, nil
+}
+
+// SyncPrimaryCategoryName propaga o nome da categoria para os restaurantes em que ela é a principal
+func (r *RestaurantRepository) SyncPrimaryCategoryName(ctx context.Context, id uuid.UUID) error {
+	if err := r.q(ctx).SyncPrimaryCategoryName(ctx, id); err != nil {
+		return fmt.Errorf("restaurant repository: sync primary category name: %w", err)
+	}
+	return nil
+}
+
+// ResolveCategories busca as categorias informadas por ID ou slug
+// Referências desconhecidas são ignoradas; cabe ao chamador conferir o resultado
+func (r *RestaurantRepository) ResolveCategories(ctx context.Context, ids []uuid.UUID, slugs []string) ([]domain.Category, error) {
+	dbCategories, err := r.q(ctx).GetCategoriesByRefs(ctx, database.GetCategoriesByRefsParams{Ids: ids, Slugs: slugs})
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: resolve categories: %w", err)
+	}
+
+	return categoriesToDomain(dbCategories), nil
+}
+
+// ReplaceCategories substitui as categorias do restaurante, na ordem informada (a primeira é a principal)
+// Deve ser chamado dentro da transação que atualiza o perfil
+func (r *RestaurantRepository) ReplaceCategories(ctx context.Context, restaurantID uuid.UUID, categories []domain.Category) error {
+	if err := r.q(ctx).DeleteRestaurantCategories(ctx, restaurantID); err != nil {
+		return fmt.Errorf("restaurant repository: delete restaurant categories: %w", err)
+	}
+	return r.addCategories(ctx, restaurantID, categories)
+}
+
+// addCategories associa as categorias na ordem informada (position 0 = principal)
+func (r *RestaurantRepository) addCategories(ctx context.Context, restaurantID uuid.UUID, categories []domain.Category) error {
+	for i, category := range categories {
+		err := r.q(ctx).AddRestaurantCategory(ctx, database.AddRestaurantCategoryParams{
+			RestaurantID: restaurantID,
+			CategoryID:   category.ID,
+			Position:     int32(i),
+		})
+		if err != nil {
+			return fmt.Errorf("restaurant repository: add category: %w", err)
+		}
+	}
+	return nil
+}
+
+// categoriesToDomain converte as categorias do banco para entidades de domínio
+func categoriesToDomain(dbCategories []database.Category) []domain.Category {
+	categories := make([]domain.Category, 0, len(dbCategories))
+	for _, dbCategory := range dbCategories {
+		categories = append(categories, categoryToDomain(dbCategory))
+	}
+	return categories
+}
+
+// categoryToDomain converte uma categoria do banco para entidade de domínio
+func categoryToDomain(dbCategory database.Category) domain.Category {
+	category := domain.Category{
+		ID:        dbCategory.ID,
+		Slug:      dbCategory.Slug,
+		Name:      dbCategory.Name,
+		Position:  int(dbCategory.Position),
+		CreatedAt: dbCategory.CreatedAt.Time,
+		UpdatedAt: dbCategory.UpdatedAt.Time,
+	}
+	if dbCategory.Icon.Valid {
+		category.Icon = dbCategory.Icon.String
+	}
+	return category
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"gastro-go/internal/domain"
+)
+
+// toFloat8 converte um ponteiro opcional para pgtype.Float8 (nil vira NULL)
+func toFloat8(value *float64) pgtype.Float8 {
+	if value == nil {
+		return pgtype.Float8{}
+	}
+	return pgtype.Float8{Float64: *value, Valid: true}
+}
+
+// fromFloat8 converte pgtype.Float8 para ponteiro opcional (NULL vira nil)
+func fromFloat8(value pgtype.Float8) *float64 {
+	if !value.Valid {
+		return nil
+	}
+	v := value.Float64
+	return &v
+}
+
+// toDate converte uma data "2006-01-02" para pgtype.Date
+func toDate(value string) (pgtype.Date, error) {
+	t, err := domain.ParseDate(value)
+	if err != nil {
+		return pgtype.Date{}, err
+	}
+	return pgtype.Date{Time: t, Valid: true}, nil
+}
+
+// fromDate converte pgtype.Date para o formato "2006-01-02" (NULL vira "")
+func fromDate(value pgtype.Date) string {
+	if !value.Valid {
+		return ""
+	}
+	return value.Time.Format(domain.DateLayout)
+}
+
+// toText converte uma string opcional para pgtype.Text ("" vira NULL)
+func toText(value string) pgtype.Text {
+	if value == "" {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: value, Valid: true}
+}
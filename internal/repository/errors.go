@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQLSTATEs do PostgreSQL traduzidos em erros de domínio
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
+)
+
+// Constraints de unicidade traduzidas em erros de conflito
+const (
+	constraintRestaurantSlug        = "idx_restaurants_slug"
+	constraintRestaurantSlugHistory = "restaurant_slug_history_pkey"
+	constraintCategorySlug          = "idx_categories_slug"
+)
+
+// Constraints de chave estrangeira traduzidas em erros de conflito
+const (
+	constraintRestaurantCategoryCategory = "restaurant_categories_category_id_fkey"
+)
+
+// isUniqueViolation informa se err é uma violação de unicidade da constraint informada
+// Cobre a corrida entre a verificação prévia (SlugExists) e o insert
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == constraint
+}
+
+// isForeignKeyViolation informa se err é uma violação da chave estrangeira informada
+// Cobre a corrida entre uma verificação prévia e a remoção da linha referenciada
+func isForeignKeyViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation && pgErr.ConstraintName == constraint
+}
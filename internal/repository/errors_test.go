@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsUniqueViolation(t *testing.T) {
+	// Input
+	slugViolation := fmt.Errorf("insert: %w", &pgconn.PgError{Code: uniqueViolation, ConstraintName: constraintRestaurantSlug})
+	otherConstraint := &pgconn.PgError{Code: uniqueViolation, ConstraintName: "restaurant_addresses_restaurant_id_key"}
+	otherCode := &pgconn.PgError{Code: "23503", ConstraintName: constraintRestaurantSlug}
+
+	// Assert
+	assert.True(t, isUniqueViolation(slugViolation, constraintRestaurantSlug))
+	assert.False(t, isUniqueViolation(otherConstraint, constraintRestaurantSlug))
+	assert.False(t, isUniqueViolation(otherCode, constraintRestaurantSlug))
+	assert.False(t, isUniqueViolation(errors.New("boom"), constraintRestaurantSlug))
+}
@@ -12,10 +12,20 @@ import (
 type RestaurantRepositoryInterface interface {
 	Create(ctx context.Context, restaurant *domain.Restaurant) error
 	GetByID(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error)
+	GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error)
 	GetBySlug(ctx context.Context, slug string) (*domain.Restaurant, error)
-	SlugExists(ctx context.Context, slug string) (bool, error)
-	List(ctx context.Context, limit, offset int32) ([]*domain.Restaurant, error)
-	UpdateStatus(ctx context.Context, id uuid.UUID, status string) error
+	SlugExists(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error)
+	ListTakenSlugs(ctx context.Context, base string) ([]string, error)
+	GetCanonicalSlug(ctx context.Context, slug string) (string, error)
+	RetireSlug(ctx context.Context, restaurantID uuid.UUID, oldSlug, newSlug string) error
+	List(ctx context.Context, filter domain.RestaurantFilter, sort domain.RestaurantSort, cursor *domain.ListCursor, limit, offset int32) ([]*domain.Restaurant, error)
+	Count(ctx context.Context, filter domain.RestaurantFilter) (int64, error)
+	CountByCategory(ctx context.Context, filter domain.RestaurantFilter) ([]domain.FacetValue, error)
+	CountByCity(ctx context.Context, filter domain.RestaurantFilter) ([]domain.FacetValue, error)
+	CountByPaymentMethod(ctx context.Context, filter domain.RestaurantFilter) ([]domain.FacetValue, error)
+	ListNearby(ctx context.Context, search domain.NearbySearch, limit int32) ([]*domain.Restaurant, error)
+	UpdateStatus(ctx context.Context, id uuid.UUID, fromStatus, status, reason string) error
+	UpdateProfile(ctx context.Context, restaurant *domain.Restaurant, expectedVersion int) error
 	CreateAddress(ctx context.Context, address *domain.Address) error
 	UpdateAddress(ctx context.Context, address *domain.Address) error
 	GetAddress(ctx context.Context, restaurantID uuid.UUID) (*domain.Address, error)
@@ -25,5 +35,45 @@ type RestaurantRepositoryInterface interface {
 	CreatePaymentMethod(ctx context.Context, method *domain.PaymentMethod) error
 	DeletePaymentMethodsByRestaurant(ctx context.Context, restaurantID uuid.UUID) error
 	GetPaymentMethods(ctx context.Context, restaurantID uuid.UUID) ([]*domain.PaymentMethod, error)
+	RecordStatusChange(ctx context.Context, change *domain.StatusChange) error
+	ListStatusHistory(ctx context.Context, restaurantID uuid.UUID, limit, offset int32) ([]*domain.StatusChange, error)
+	CountStatusHistory(ctx context.Context, restaurantID uuid.UUID) (int64, error)
+	CreateSpecialHours(ctx context.Context, special *domain.SpecialHours) error
+	DeleteSpecialHours(ctx context.Context, restaurantID uuid.UUID, date string) (int64, error)
+	ListSpecialHours(ctx context.Context, restaurantID uuid.UUID, from, to string) ([]*domain.SpecialHours, error)
+	CreateDeliveryArea(ctx context.Context, area *domain.DeliveryArea) error
+	DeleteDeliveryAreasByRestaurant(ctx context.Context, restaurantID uuid.UUID) error
+	GetDeliveryAreas(ctx context.Context, restaurantID uuid.UUID) ([]domain.DeliveryArea, error)
+	CreateCategory(ctx context.Context, category *domain.Category) error
+	UpdateCategory(ctx context.Context, category *domain.Category) error
+	DeleteCategory(ctx context.Context, id uuid.UUID) error
+	GetCategory(ctx context.Context, id uuid.UUID) (*domain.Category, error)
+	ListCategories(ctx context.Context) ([]domain.Category, error)
+	CategorySlugExists(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error)
+	SyncPrimaryCategoryName(ctx context.Context, id uuid.UUID) error
+	ResolveCategories(ctx context.Context, ids []uuid.UUID, slugs []string) ([]domain.Category, error)
+	ReplaceCategories(ctx context.Context, restaurantID uuid.UUID, categories []domain.Category) error
+	CreateMenuSection(ctx context.Context, section *domain.MenuSection) error
+	GetMenuSection(ctx context.Context, restaurantID, id uuid.UUID) (*domain.MenuSection, error)
+	ListMenuSections(ctx context.Context, restaurantID uuid.UUID) ([]domain.MenuSection, error)
+	UpdateMenuSection(ctx context.Context, section *domain.MenuSection) error
+	DeleteMenuSection(ctx context.Context, restaurantID, id uuid.UUID) error
+	CreateMenuItem(ctx context.Context, item *domain.MenuItem) error
+	GetMenuItem(ctx context.Context, restaurantID, id uuid.UUID) (*domain.MenuItem, error)
+	ListMenuItems(ctx context.Context, restaurantID uuid.UUID) ([]domain.MenuItem, error)
+	UpdateMenuItem(ctx context.Context, item *domain.MenuItem) error
+	DeleteMenuItem(ctx context.Context, restaurantID, id uuid.UUID) error
+	CreateMenuOptionGroup(ctx context.Context, group *domain.MenuOptionGroup) error
+	CreateMenuOption(ctx context.Context, option *domain.MenuOption) error
+	UpdateMenuOptionGroup(ctx context.Context, group *domain.MenuOptionGroup) error
+	UpdateMenuOption(ctx context.Context, option *domain.MenuOption) error
+	DeleteMenuOptionGroupsExcept(ctx context.Context, restaurantID, itemID uuid.UUID, keepIDs []uuid.UUID) error
+	DeleteMenuOptionsExcept(ctx context.Context, restaurantID, groupID uuid.UUID, keepIDs []uuid.UUID) error
+	ListMenuOptionGroups(ctx context.Context, restaurantID uuid.UUID) ([]domain.MenuOptionGroup, error)
+	ListMenuItemOptionGroups(ctx context.Context, restaurantID, itemID uuid.UUID) ([]domain.MenuOptionGroup, error)
+	CreateMenuSectionWindow(ctx context.Context, sectionID uuid.UUID, window *domain.OpeningHour) error
+	CreateMenuItemWindow(ctx context.Context, itemID uuid.UUID, window *domain.OpeningHour) error
+	DeleteMenuSectionAvailability(ctx context.Context, restaurantID, sectionID uuid.UUID) error
+	DeleteMenuItemAvailability(ctx context.Context, restaurantID, itemID uuid.UUID) error
 }
 
@@ -0,0 +1,227 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"gastro-go/internal/database"
+	"gastro-go/internal/domain"
+)
+
+// CreateMenuSection cria uma seção do cardápio
+func (r *RestaurantRepository) CreateMenuSection(ctx context.Context, section *domain.MenuSection) error {
+	dbSection, err := r.q(ctx).CreateMenuSection(ctx, database.CreateMenuSectionParams{
+		RestaurantID: section.RestaurantID,
+		Name:         section.Name,
+		Description:  toText(section.Description),
+		Position:     int32(section.Position),
+		Active:       section.Active,
+	})
+	if err != nil {
+		return fmt.Errorf("restaurant repository: create menu section: %w", err)
+	}
+
+	*section = menuSectionToDomain(dbSection)
+	return nil
+}
+
+// GetMenuSection busca uma seção do cardápio do restaurante
+func (r *RestaurantRepository) GetMenuSection(ctx context.Context, restaurantID, id uuid.UUID) (*domain.MenuSection, error) {
+	dbSection, err := r.q(ctx).GetMenuSection(ctx, database.GetMenuSectionParams{ID: id, RestaurantID: restaurantID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("restaurant repository: %w", domain.ErrMenuSectionNotFound.Wrap(err))
+		}
+		return nil, fmt.Errorf("restaurant repository: get menu section: %w", err)
+	}
+
+	section := menuSectionToDomain(dbSection)
+	return &section, nil
+}
+
+// ListMenuSections lista as seções do cardápio, com as janelas de venda, na ordem de exibição
+func (r *RestaurantRepository) ListMenuSections(ctx context.Context, restaurantID uuid.UUID) ([]domain.MenuSection, error) {
+	dbSections, err := r.q(ctx).ListMenuSections(ctx, restaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: list menu sections: %w", err)
+	}
+	dbWindows, err := r.q(ctx).ListMenuSectionAvailability(ctx, restaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: list menu section availability: %w", err)
+	}
+	windows := groupMenuWindows(dbWindows, func(row database.MenuAvailabilityWindow) pgtype.UUID { return row.SectionID })
+
+	sections := make([]domain.MenuSection, 0, len(dbSections))
+	for _, dbSection := range dbSections {
+		section := menuSectionToDomain(dbSection)
+		section.Availability = windows[section.ID]
+		if section.Availability == nil {
+			section.Availability = []domain.OpeningHour{}
+		}
+		sections = append(sections, section)
+	}
+	return sections, nil
+}
+
+// UpdateMenuSection atualiza nome, descrição, posição e status de uma seção
+func (r *RestaurantRepository) UpdateMenuSection(ctx context.Context, section *domain.MenuSection) error {
+	dbSection, err := r.q(ctx).UpdateMenuSection(ctx, database.UpdateMenuSectionParams{
+		ID:           section.ID,
+		RestaurantID: section.RestaurantID,
+		Name:         section.Name,
+		Description:  toText(section.Description),
+		Position:     int32(section.Position),
+		Active:       section.Active,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("restaurant repository: %w", domain.ErrMenuSectionNotFound.Wrap(err))
+		}
+		return fmt.Errorf("restaurant repository: update menu section: %w", err)
+	}
+
+	*section = menuSectionToDomain(dbSection)
+	return nil
+}
+
+// DeleteMenuSection remove uma seção e, em cascata, os seus itens
+func (r *RestaurantRepository) DeleteMenuSection(ctx context.Context, restaurantID, id uuid.UUID) error {
+	deleted, err := r.q(ctx).DeleteMenuSection(ctx, database.DeleteMenuSectionParams{ID: id, RestaurantID: restaurantID})
+	if err != nil {
+		return fmt.Errorf("restaurant repository: delete menu section: %w", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("restaurant repository: %w", domain.ErrMenuSectionNotFound)
+	}
+	return nil
+}
+
+// CreateMenuItem cria um item do cardápio
+func (r *RestaurantRepository) CreateMenuItem(ctx context.Context, item *domain.MenuItem) error {
+	dbItem, err := r.q(ctx).CreateMenuItem(ctx, database.CreateMenuItemParams{
+		RestaurantID: item.RestaurantID,
+		SectionID:    item.SectionID,
+		Name:         item.Name,
+		Description:  toText(item.Description),
+		Price:        item.Price,
+		ImageUrl:     toText(item.ImageURL),
+		Position:     int32(item.Position),
+		Active:       item.Active,
+	})
+	if err != nil {
+		return fmt.Errorf("restaurant repository: create menu item: %w", err)
+	}
+
+	*item = menuItemToDomain(dbItem)
+	return nil
+}
+
+// GetMenuItem busca um item do cardápio do restaurante
+func (r *RestaurantRepository) GetMenuItem(ctx context.Context, restaurantID, id uuid.UUID) (*domain.MenuItem, error) {
+	dbItem, err := r.q(ctx).GetMenuItem(ctx, database.GetMenuItemParams{ID: id, RestaurantID: restaurantID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("restaurant repository: %w", domain.ErrMenuItemNotFound.Wrap(err))
+		}
+		return nil, fmt.Errorf("restaurant repository: get menu item: %w", err)
+	}
+
+	item := menuItemToDomain(dbItem)
+	return &item, nil
+}
+
+// ListMenuItems lista os itens do cardápio, com as janelas de venda, na ordem de exibição
+func (r *RestaurantRepository) ListMenuItems(ctx context.Context, restaurantID uuid.UUID) ([]domain.MenuItem, error) {
+	dbItems, err := r.q(ctx).ListMenuItems(ctx, restaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: list menu items: %w", err)
+	}
+	dbWindows, err := r.q(ctx).ListMenuItemAvailability(ctx, restaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: list menu item availability: %w", err)
+	}
+	windows := groupMenuWindows(dbWindows, func(row database.MenuAvailabilityWindow) pgtype.UUID { return row.ItemID })
+
+	items := make([]domain.MenuItem, 0, len(dbItems))
+	for _, dbItem := range dbItems {
+		item := menuItemToDomain(dbItem)
+		item.Availability = windows[item.ID]
+		if item.Availability == nil {
+			item.Availability = []domain.OpeningHour{}
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// UpdateMenuItem atualiza um item do cardápio, inclusive a seção a que pertence
+func (r *RestaurantRepository) UpdateMenuItem(ctx context.Context, item *domain.MenuItem) error {
+	dbItem, err := r.q(ctx).UpdateMenuItem(ctx, database.UpdateMenuItemParams{
+		ID:           item.ID,
+		RestaurantID: item.RestaurantID,
+		SectionID:    item.SectionID,
+		Name:         item.Name,
+		Description:  toText(item.Description),
+		Price:        item.Price,
+		ImageUrl:     toText(item.ImageURL),
+		Position:     int32(item.Position),
+		Active:       item.Active,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("restaurant repository: %w", domain.ErrMenuItemNotFound.Wrap(err))
+		}
+		return fmt.Errorf("restaurant repository: update menu item: %w", err)
+	}
+
+	*item = menuItemToDomain(dbItem)
+	return nil
+}
+
+// DeleteMenuItem remove um item do cardápio
+func (r *RestaurantRepository) DeleteMenuItem(ctx context.Context, restaurantID, id uuid.UUID) error {
+	deleted, err := r.q(ctx).DeleteMenuItem(ctx, database.DeleteMenuItemParams{ID: id, RestaurantID: restaurantID})
+	if err != nil {
+		return fmt.Errorf("restaurant repository: delete menu item: %w", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("restaurant repository: %w", domain.ErrMenuItemNotFound)
+	}
+	return nil
+}
+
+// menuSectionToDomain converte uma seção do banco para entidade de domínio
+func menuSectionToDomain(dbSection database.MenuSection) domain.MenuSection {
+	return domain.MenuSection{
+		ID:           dbSection.ID,
+		RestaurantID: dbSection.RestaurantID,
+		Name:         dbSection.Name,
+		Description:  dbSection.Description.String,
+		Position:     int(dbSection.Position),
+		Active:       dbSection.Active,
+		CreatedAt:    dbSection.CreatedAt.Time,
+		UpdatedAt:    dbSection.UpdatedAt.Time,
+	}
+}
+
+// menuItemToDomain converte um item do banco para entidade de domínio
+func menuItemToDomain(dbItem database.MenuItem) domain.MenuItem {
+	return domain.MenuItem{
+		ID:           dbItem.ID,
+		RestaurantID: dbItem.RestaurantID,
+		SectionID:    dbItem.SectionID,
+		Name:         dbItem.Name,
+		Description:  dbItem.Description.String,
+		Price:        dbItem.Price,
+		ImageURL:     dbItem.ImageUrl.String,
+		Position:     int(dbItem.Position),
+		Active:       dbItem.Active,
+		CreatedAt:    dbItem.CreatedAt.Time,
+		UpdatedAt:    dbItem.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"gastro-go/internal/database"
+	"gastro-go/internal/domain"
+)
+
+// CreateMenuSectionWindow cria uma janela de venda de uma seção do cardápio
+func (r *RestaurantRepository) CreateMenuSectionWindow(ctx context.Context, sectionID uuid.UUID, window *domain.OpeningHour) error {
+	dbWindow, err := r.q(ctx).CreateMenuAvailabilityWindow(ctx, database.CreateMenuAvailabilityWindowParams{
+		RestaurantID: window.RestaurantID,
+		SectionID:    pgtype.UUID{Bytes: sectionID, Valid: true},
+		Weekday:      int32(window.Weekday),
+		OpensAt:      int32(window.OpensAt),
+		ClosesAt:     int32(window.ClosesAt),
+	})
+	if err != nil {
+		return fmt.Errorf("restaurant repository: create menu section window: %w", err)
+	}
+
+	window.ID = dbWindow.ID
+	return nil
+}
+
+// CreateMenuItemWindow cria uma janela de venda de um item do cardápio
+func (r *RestaurantRepository) CreateMenuItemWindow(ctx context.Context, itemID uuid.UUID, window *domain.OpeningHour) error {
+	dbWindow, err := r.q(ctx).CreateMenuAvailabilityWindow(ctx, database.CreateMenuAvailabilityWindowParams{
+		RestaurantID: window.RestaurantID,
+		ItemID:       pgtype.UUID{Bytes: itemID, Valid: true},
+		Weekday:      int32(window.Weekday),
+		OpensAt:      int32(window.OpensAt),
+		ClosesAt:     int32(window.ClosesAt),
+	})
+	if err != nil {
+		return fmt.Errorf("restaurant repository: create menu item window: %w", err)
+	}
+
+	window.ID = dbWindow.ID
+	return nil
+}
+
+// DeleteMenuSectionAvailability remove todas as janelas de venda de uma seção
+func (r *RestaurantRepository) DeleteMenuSectionAvailability(ctx context.Context, restaurantID, sectionID uuid.UUID) error {
+	err := r.q(ctx).DeleteMenuSectionAvailability(ctx, database.DeleteMenuSectionAvailabilityParams{
+		SectionID:    pgtype.UUID{Bytes: sectionID, Valid: true},
+		RestaurantID: restaurantID,
+	})
+	if err != nil {
+		return fmt.Errorf("restaurant repository: delete menu section availability: %w", err)
+	}
+	return nil
+}
+
+// DeleteMenuItemAvailability remove todas as janelas de venda de um item
+func (r *RestaurantRepository) DeleteMenuItemAvailability(ctx context.Context, restaurantID, itemID uuid.UUID) error {
+	err := r.q(ctx).DeleteMenuItemAvailability(ctx, database.DeleteMenuItemAvailabilityParams{
+		ItemID:       pgtype.UUID{Bytes: itemID, Valid: true},
+		RestaurantID: restaurantID,
+	})
+	if err != nil {
+		return fmt.Errorf("restaurant repository: delete menu item availability: %w", err)
+	}
+	return nil
+}
+
+// groupMenuWindows agrupa as janelas de venda pela seção ou item a que pertencem
+func groupMenuWindows(dbWindows []database.MenuAvailabilityWindow, owner func(database.MenuAvailabilityWindow) pgtype.UUID) map[uuid.UUID][]domain.OpeningHour {
+	windows := make(map[uuid.UUID][]domain.OpeningHour)
+	for _, dbWindow := range dbWindows {
+		id := uuid.UUID(owner(dbWindow).Bytes)
+		windows[id] = append(windows[id], domain.OpeningHour{
+			ID:           dbWindow.ID,
+			RestaurantID: dbWindow.RestaurantID,
+			Weekday:      int(dbWindow.Weekday),
+			OpensAt:      int(dbWindow.OpensAt),
+			ClosesAt:     int(dbWindow.ClosesAt),
+		})
+	}
+	return windows
+}
@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"gastro-go/internal/database"
+	"gastro-go/internal/domain"
+)
+
+// CreateMenuOptionGroup cria um grupo de opções de um item (sem as opções)
+func (r *RestaurantRepository) CreateMenuOptionGroup(ctx context.Context, group *domain.MenuOptionGroup) error {
+	dbGroup, err := r.q(ctx).CreateMenuOptionGroup(ctx, database.CreateMenuOptionGroupParams{
+		RestaurantID:  group.RestaurantID,
+		ItemID:        group.ItemID,
+		Name:          group.Name,
+		MinSelections: int32(group.MinSelections),
+		MaxSelections: int32(group.MaxSelections),
+		Required:      group.Required,
+		Position:      int32(group.Position),
+	})
+	if err != nil {
+		return fmt.Errorf("restaurant repository: create menu option group: %w", err)
+	}
+
+	options := group.Options
+	*group = menuOptionGroupToDomain(dbGroup)
+	group.Options = options
+	return nil
+}
+
+// CreateMenuOption cria uma opção de um grupo
+func (r *RestaurantRepository) CreateMenuOption(ctx context.Context, option *domain.MenuOption) error {
+	dbOption, err := r.q(ctx).CreateMenuOption(ctx, database.CreateMenuOptionParams{
+		RestaurantID: option.RestaurantID,
+		GroupID:      option.GroupID,
+		Name:         option.Name,
+		PriceDelta:   option.PriceDelta,
+		Position:     int32(option.Position),
+		Active:       option.Active,
+	})
+	if err != nil {
+		return fmt.Errorf("restaurant repository: create menu option: %w", err)
+	}
+
+	*option = menuOptionToDomain(dbOption)
+	return nil
+}
+
+// UpdateMenuOptionGroup atualiza os dados de um grupo de opções do item (sem as opções)
+func (r *RestaurantRepository) UpdateMenuOptionGroup(ctx context.Context, group *domain.MenuOptionGroup) error {
+	dbGroup, err := r.q(ctx).UpdateMenuOptionGroup(ctx, database.UpdateMenuOptionGroupParams{
+		ID:            group.ID,
+		RestaurantID:  group.RestaurantID,
+		ItemID:        group.ItemID,
+		Name:          group.Name,
+		MinSelections: int32(group.MinSelections),
+		MaxSelections: int32(group.MaxSelections),
+		Required:      group.Required,
+		Position:      int32(group.Position),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("restaurant repository: %w", domain.ErrMenuOptionGroupNotFound.Wrap(err))
+		}
+		return fmt.Errorf("restaurant repository: update menu option group: %w", err)
+	}
+
+	options := group.Options
+	*group = menuOptionGroupToDomain(dbGroup)
+	group.Options = options
+	return nil
+}
+
+// UpdateMenuOption atualiza uma opção de um grupo
+func (r *RestaurantRepository) UpdateMenuOption(ctx context.Context, option *domain.MenuOption) error {
+	dbOption, err := r.q(ctx).UpdateMenuOption(ctx, database.UpdateMenuOptionParams{
+		ID:           option.ID,
+		RestaurantID: option.RestaurantID,
+		GroupID:      option.GroupID,
+		Name:         option.Name,
+		PriceDelta:   option.PriceDelta,
+		Position:     int32(option.Position),
+		Active:       option.Active,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("restaurant repository: %w", domain.ErrMenuOptionNotFound.Wrap(err))
+		}
+		return fmt.Errorf("restaurant repository: update menu option: %w", err)
+	}
+
+	*option = menuOptionToDomain(dbOption)
+	return nil
+}
+
+// DeleteMenuOptionGroupsExcept remove os grupos do item que não estão em keepIDs e, em cascata, as suas opções
+func (r *RestaurantRepository) DeleteMenuOptionGroupsExcept(ctx context.Context, restaurantID, itemID uuid.UUID, keepIDs []uuid.UUID) error {
+	err := r.q(ctx).DeleteMenuOptionGroupsByItemExcept(ctx, database.DeleteMenuOptionGroupsByItemExceptParams{
+		ItemID:       itemID,
+		RestaurantID: restaurantID,
+		KeepIds:      nonNilIDs(keepIDs),
+	})
+	if err != nil {
+		return fmt.Errorf("restaurant repository: delete menu option groups: %w", err)
+	}
+	return nil
+}
+
+// DeleteMenuOptionsExcept remove as opções do grupo que não estão em keepIDs
+func (r *RestaurantRepository) DeleteMenuOptionsExcept(ctx context.Context, restaurantID, groupID uuid.UUID, keepIDs []uuid.UUID) error {
+	err := r.q(ctx).DeleteMenuOptionsByGroupExcept(ctx, database.DeleteMenuOptionsByGroupExceptParams{
+		GroupID:      groupID,
+		RestaurantID: restaurantID,
+		KeepIds:      nonNilIDs(keepIDs),
+	})
+	if err != nil {
+		return fmt.Errorf("restaurant repository: delete menu options: %w", err)
+	}
+	return nil
+}
+
+// ListMenuOptionGroups lista os grupos de opções do restaurante, já com as opções, na ordem de exibição
+func (r *RestaurantRepository) ListMenuOptionGroups(ctx context.Context, restaurantID uuid.UUID) ([]domain.MenuOptionGroup, error) {
+	dbGroups, err := r.q(ctx).ListMenuOptionGroups(ctx, restaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: list menu option groups: %w", err)
+	}
+	dbOptions, err := r.q(ctx).ListMenuOptions(ctx, restaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: list menu options: %w", err)
+	}
+	return assembleMenuOptionGroups(dbGroups, dbOptions), nil
+}
+
+// ListMenuItemOptionGroups lista os grupos de opções de um item, já com as opções, na ordem de exibição
+func (r *RestaurantRepository) ListMenuItemOptionGroups(ctx context.Context, restaurantID, itemID uuid.UUID) ([]domain.MenuOptionGroup, error) {
+	dbGroups, err := r.q(ctx).ListMenuOptionGroupsByItem(ctx, database.ListMenuOptionGroupsByItemParams{
+		RestaurantID: restaurantID,
+		ItemID:       itemID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: list menu item option groups: %w", err)
+	}
+	dbOptions, err := r.q(ctx).ListMenuOptionsByItem(ctx, database.ListMenuOptionsByItemParams{
+		RestaurantID: restaurantID,
+		ItemID:       itemID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: list menu item options: %w", err)
+	}
+	return assembleMenuOptionGroups(dbGroups, dbOptions), nil
+}
+
+// assembleMenuOptionGroups agrupa as opções nos seus grupos, preservando a ordem de ambos
+func assembleMenuOptionGroups(dbGroups []database.MenuOptionGroup, dbOptions []database.MenuOption) []domain.MenuOptionGroup {
+	byGroup := make(map[uuid.UUID][]domain.MenuOption, len(dbGroups))
+	for _, dbOption := range dbOptions {
+		byGroup[dbOption.GroupID] = append(byGroup[dbOption.GroupID], menuOptionToDomain(dbOption))
+	}
+
+	groups := make([]domain.MenuOptionGroup, 0, len(dbGroups))
+	for _, dbGroup := range dbGroups {
+		group := menuOptionGroupToDomain(dbGroup)
+		group.Options = byGroup[group.ID]
+		if group.Options == nil {
+			group.Options = []domain.MenuOption{}
+		}
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+// nonNilIDs garante um array vazio em vez de NULL: "id <> ALL(NULL)" não casa nenhuma linha
+func nonNilIDs(ids []uuid.UUID) []uuid.UUID {
+	if ids == nil {
+		return []uuid.UUID{}
+	}
+	return ids
+}
+
+// menuOptionGroupToDomain converte um grupo de opções do banco para entidade de domínio
+func menuOptionGroupToDomain(dbGroup database.MenuOptionGroup) domain.MenuOptionGroup {
+	return domain.MenuOptionGroup{
+		ID:            dbGroup.ID,
+		RestaurantID:  dbGroup.RestaurantID,
+		ItemID:        dbGroup.ItemID,
+		Name:          dbGroup.Name,
+		MinSelections: int(dbGroup.MinSelections),
+		MaxSelections: int(dbGroup.MaxSelections),
+		Required:      dbGroup.Required,
+		Position:      int(dbGroup.Position),
+		CreatedAt:     dbGroup.CreatedAt.Time,
+		UpdatedAt:     dbGroup.UpdatedAt.Time,
+	}
+}
+
+// menuOptionToDomain converte uma opção do banco para entidade de domínio
+func menuOptionToDomain(dbOption database.MenuOption) domain.MenuOption {
+	return domain.MenuOption{
+		ID:           dbOption.ID,
+		RestaurantID: dbOption.RestaurantID,
+		GroupID:      dbOption.GroupID,
+		Name:         dbOption.Name,
+		PriceDelta:   dbOption.PriceDelta,
+		Position:     int(dbOption.Position),
+		Active:       dbOption.Active,
+		CreatedAt:    dbOption.CreatedAt.Time,
+		UpdatedAt:    dbOption.UpdatedAt.Time,
+	}
+}
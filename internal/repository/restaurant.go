@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -24,7 +25,16 @@ func NewRestaurantRepository(queries *database.Queries) *RestaurantRepository {
 	}
 }
 
+// q retorna as queries ligadas à transação do ctx, se houver
+func (r *RestaurantRepository) q(ctx context.Context) *database.Queries {
+	if tx, ok := txFromContext(ctx); ok {
+		return r.queries.WithTx(tx)
+	}
+	return r.queries
+}
+
 // Create cria um novo restaurante
+// Insere restaurante, endereço e categorias; deve ser chamado dentro de TxRunner.RunInTx para ser atômico
 func (r *RestaurantRepository) Create(ctx context.Context, restaurant *domain.Restaurant) error {
 	// Converter para modelo do banco
 	params := database.CreateRestaurantParams{
@@ -52,14 +62,21 @@ func (r *RestaurantRepository) Create(ctx context.Context, restaurant *domain.Re
 	if restaurant.BannerURL != "" {
 		params.BannerUrl = pgtype.Text{String: restaurant.BannerURL, Valid: true}
 	}
+	if restaurant.Timezone != "" {
+		params.Timezone = pgtype.Text{String: restaurant.Timezone, Valid: true}
+	}
 
-	dbRestaurant, err := r.queries.CreateRestaurant(ctx, params)
+	dbRestaurant, err := r.q(ctx).CreateRestaurant(ctx, params)
 	if err != nil {
+		if isUniqueViolation(err, constraintRestaurantSlug) {
+			return fmt.Errorf("restaurant repository: %w", domain.ErrSlugAlreadyExists.Wrap(err))
+		}
 		return fmt.Errorf("restaurant repository: create restaurant: %w", err)
 	}
 
 	// Atualizar o restaurante com os dados retornados
 	restaurant.ID = dbRestaurant.ID
+	restaurant.Version = int(dbRestaurant.Version)
 	restaurant.CreatedAt = dbRestaurant.CreatedAt.Time
 	restaurant.UpdatedAt = dbRestaurant.UpdatedAt.Time
 
@@ -72,14 +89,14 @@ func (r *RestaurantRepository) Create(ctx context.Context, restaurant *domain.Re
 			City:         restaurant.Address.City,
 			State:        restaurant.Address.State,
 			ZipCode:      restaurant.Address.ZipCode,
-			Lat:          restaurant.Address.Lat,
-			Lng:          restaurant.Address.Lng,
+			Lat:          toFloat8(restaurant.Address.Lat),
+			Lng:          toFloat8(restaurant.Address.Lng),
 		}
 		if restaurant.Address.Complement != "" {
 			addrParams.Complement = pgtype.Text{String: restaurant.Address.Complement, Valid: true}
 		}
 
-		dbAddress, err := r.queries.CreateRestaurantAddress(ctx, addrParams)
+		dbAddress, err := r.q(ctx).CreateRestaurantAddress(ctx, addrParams)
 		if err != nil {
 			return fmt.Errorf("restaurant repository: create address: %w", err)
 		}
@@ -87,93 +104,191 @@ func (r *RestaurantRepository) Create(ctx context.Context, restaurant *domain.Re
 		restaurant.Address.ID = dbAddress.ID
 	}
 
-	return nil
+	return r.addCategories(ctx, restaurant.ID, restaurant.Categories)
 }
 
 // GetByID busca um restaurante por ID
 func (r *RestaurantRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error) {
-	dbRestaurant, err := r.queries.GetRestaurantByID(ctx, id)
+	dbRestaurant, err := r.q(ctx).GetRestaurantByID(ctx, id)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("restaurant repository: restaurant not found: %w", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("restaurant repository: %w", domain.ErrRestaurantNotFound.Wrap(err))
 		}
 		return nil, fmt.Errorf("restaurant repository: get by id: %w", err)
 	}
 
-	// Carregar relacionamentos
-	address, _ := r.queries.GetRestaurantAddress(ctx, dbRestaurant.ID)
-	openingHours, _ := r.queries.GetOpeningHoursByRestaurant(ctx, dbRestaurant.ID)
-	paymentMethods, _ := r.queries.GetPaymentMethodsByRestaurant(ctx, dbRestaurant.ID)
+	return r.loadAggregate(ctx, &dbRestaurant)
+}
+
+// GetByIDForUpdate busca um restaurante por ID travando a linha (SELECT ... FOR UPDATE)
+// Deve ser chamado dentro de TxRunner.RunInTx; a trava dura até o fim da transação
+func (r *RestaurantRepository) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error) {
+	dbRestaurant, err := r.q(ctx).GetRestaurantByIDForUpdate(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("restaurant repository: %w", domain.ErrRestaurantNotFound.Wrap(err))
+		}
+		return nil, fmt.Errorf("restaurant repository: get by id for update: %w", err)
+	}
 
-	return r.toDomain(&dbRestaurant, &address, openingHours, paymentMethods)
+	return r.loadAggregate(ctx, &dbRestaurant)
 }
 
 // GetBySlug busca um restaurante por slug
 func (r *RestaurantRepository) GetBySlug(ctx context.Context, slug string) (*domain.Restaurant, error) {
-	dbRestaurant, err := r.queries.GetRestaurantBySlug(ctx, slug)
+	dbRestaurant, err := r.q(ctx).GetRestaurantBySlug(ctx, slug)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("restaurant repository: restaurant not found: %w", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("restaurant repository: %w", domain.ErrRestaurantNotFound.Wrap(err))
 		}
 		return nil, fmt.Errorf("restaurant repository: get by slug: %w", err)
 	}
 
-	// Carregar relacionamentos
-	address, _ := r.queries.GetRestaurantAddress(ctx, dbRestaurant.ID)
-	openingHours, _ := r.queries.GetOpeningHoursByRestaurant(ctx, dbRestaurant.ID)
-	paymentMethods, _ := r.queries.GetPaymentMethodsByRestaurant(ctx, dbRestaurant.ID)
-
-	return r.toDomain(&dbRestaurant, &address, openingHours, paymentMethods)
+	return r.loadAggregate(ctx, &dbRestaurant)
 }
 
-// SlugExists verifica se um slug já existe
-func (r *RestaurantRepository) SlugExists(ctx context.Context, slug string) (bool, error) {
-	_, err := r.queries.GetRestaurantBySlug(ctx, slug)
+// List lista restaurantes com filtros, ordenados por (created_at, id) decrescente
+// Com Query, ordena primeiro pela relevância da busca textual; com sort explícito, pelo campo escolhido
+// Com cursor, retorna apenas os restaurantes depois dele (keyset); offset segue disponível
+// O filtro DeliversTo depende das áreas de cada restaurante e é aplicado pelo use case
+func (r *RestaurantRepository) List(ctx context.Context, filter domain.RestaurantFilter, sort domain.RestaurantSort, cursor *domain.ListCursor, limit, offset int32) ([]*domain.Restaurant, error) {
+	if !sort.IsDefault() {
+		return r.listSorted(ctx, filter, sort, cursor, limit, offset)
+	}
+	if filter.Query != "" {
+		return r.search(ctx, filter, cursor, limit, offset)
+	}
+
+	dbRestaurants, err := r.q(ctx).ListRestaurants(ctx, listParams(filter, cursor, limit, offset))
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return false, nil
-		}
-		return false, fmt.Errorf("restaurant repository: check slug exists: %w", err)
+		return nil, fmt.Errorf("restaurant repository: list: %w", err)
 	}
-	return true, nil
+
+	return r.loadAggregates(ctx, dbRestaurants)
 }
 
-// List lista restaurantes com paginação
-func (r *RestaurantRepository) List(ctx context.Context, limit, offset int32) ([]*domain.Restaurant, error) {
-	dbRestaurants, err := r.queries.ListRestaurants(ctx, database.ListRestaurantsParams{
-		Limit:  limit,
-		Offset: offset,
-	})
+// listSorted lista os restaurantes na ordenação explícita, desempatando por id
+// Com ponto de referência, DistanceMeters vem preenchido (nil quando o restaurante não tem coordenadas)
+func (r *RestaurantRepository) listSorted(ctx context.Context, filter domain.RestaurantFilter, sort domain.RestaurantSort, cursor *domain.ListCursor, limit, offset int32) ([]*domain.Restaurant, error) {
+	rows, err := r.q(ctx).ListRestaurantsSorted(ctx, sortedParams(filter, sort, cursor, limit, offset))
 	if err != nil {
-		return nil, fmt.Errorf("restaurant repository: list: %w", err)
+		return nil, fmt.Errorf("restaurant repository: list sorted: %w", err)
 	}
 
-	restaurants := make([]*domain.Restaurant, 0, len(dbRestaurants))
-	for _, dbRestaurant := range dbRestaurants {
-		// Carregar relacionamentos para cada restaurante
-		address, _ := r.queries.GetRestaurantAddress(ctx, dbRestaurant.ID)
-		openingHours, _ := r.queries.GetOpeningHoursByRestaurant(ctx, dbRestaurant.ID)
-		paymentMethods, _ := r.queries.GetPaymentMethodsByRestaurant(ctx, dbRestaurant.ID)
+	dbRestaurants := make([]database.Restaurant, 0, len(rows))
+	for _, row := range rows {
+		dbRestaurants = append(dbRestaurants, row.Restaurant)
+	}
+	restaurants, err := r.loadAggregates(ctx, dbRestaurants)
+	if err != nil {
+		return nil, err
+	}
+	for i := range restaurants {
+		restaurants[i].DistanceMeters = fromFloat8(rows[i].DistanceM)
+	}
 
-		restaurant, err := r.toDomain(&dbRestaurant, &address, openingHours, paymentMethods)
-		if err != nil {
-			return nil, err
-		}
-		restaurants = append(restaurants, restaurant)
+	return restaurants, nil
+}
+
+// search lista os restaurantes que casam com a busca textual, do mais relevante ao menos relevante
+// SearchRank vem preenchido em cada item para compor o cursor da próxima página
+func (r *RestaurantRepository) search(ctx context.Context, filter domain.RestaurantFilter, cursor *domain.ListCursor, limit, offset int32) ([]*domain.Restaurant, error) {
+	rows, err := r.q(ctx).SearchRestaurants(ctx, searchParams(filter, cursor, limit, offset))
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: search: %w", err)
+	}
+
+	dbRestaurants := make([]database.Restaurant, 0, len(rows))
+	for _, row := range rows {
+		dbRestaurants = append(dbRestaurants, row.Restaurant)
+	}
+	restaurants, err := r.loadAggregates(ctx, dbRestaurants)
+	if err != nil {
+		return nil, err
+	}
+	for i := range restaurants {
+		rank := rows[i].SearchRank
+		restaurants[i].SearchRank = &rank
 	}
 
 	return restaurants, nil
 }
 
-// UpdateStatus atualiza o status de um restaurante
-func (r *RestaurantRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status string) error {
-	_, err := r.queries.UpdateRestaurantStatus(ctx, database.UpdateRestaurantStatusParams{
-		ID:     id,
-		Status: status,
-	})
+// Count conta os restaurantes que atendem aos filtros (exceto DeliversTo)
+func (r *RestaurantRepository) Count(ctx context.Context, filter domain.RestaurantFilter) (int64, error) {
+	count, err := r.q(ctx).CountRestaurants(ctx, countParams(filter))
+	if err != nil {
+		return 0, fmt.Errorf("restaurant repository: count: %w", err)
+	}
+	return count, nil
+}
+
+// UpdateStatus atualiza o status de um restaurante e o motivo da mudança
+// Só aplica a transição se o status persistido ainda for fromStatus
+func (r *RestaurantRepository) UpdateStatus(ctx context.Context, id uuid.UUID, fromStatus, status, reason string) error {
+	params := database.UpdateRestaurantStatusParams{
+		ID:         id,
+		Status:     status,
+		FromStatus: fromStatus,
+	}
+	if reason != "" {
+		params.StatusReason = pgtype.Text{String: reason, Valid: true}
+	}
+
+	updated, err := r.q(ctx).UpdateRestaurantStatus(ctx, params)
 	if err != nil {
 		return fmt.Errorf("restaurant repository: update status: %w", err)
 	}
+	if updated == 0 {
+		return fmt.Errorf("restaurant repository: %w", domain.ErrStatusChanged)
+	}
+	return nil
+}
+
+// UpdateProfile atualiza os dados cadastrais de um restaurante
+// Só aplica a alteração se a versão persistida for igual a expectedVersion
+func (r *RestaurantRepository) UpdateProfile(ctx context.Context, restaurant *domain.Restaurant, expectedVersion int) error {
+	params := database.UpdateRestaurantProfileParams{
+		ID:                 restaurant.ID,
+		Name:               restaurant.Name,
+		Slug:               restaurant.Slug,
+		DeliveryFee:        restaurant.DeliveryFee,
+		MinOrderValue:      restaurant.MinOrderValue,
+		PreparationTimeMin: int32(restaurant.PreparationTimeMin),
+		SupportsPickup:     restaurant.SupportsPickup,
+		SupportsDelivery:   restaurant.SupportsDelivery,
+		Version:            int32(expectedVersion),
+	}
+
+	if restaurant.Description != "" {
+		params.Description = pgtype.Text{String: restaurant.Description, Valid: true}
+	}
+	if restaurant.Category != "" {
+		params.Category = pgtype.Text{String: restaurant.Category, Valid: true}
+	}
+	if restaurant.LogoURL != "" {
+		params.LogoUrl = pgtype.Text{String: restaurant.LogoURL, Valid: true}
+	}
+	if restaurant.BannerURL != "" {
+		params.BannerUrl = pgtype.Text{String: restaurant.BannerURL, Valid: true}
+	}
+	if restaurant.Timezone != "" {
+		params.Timezone = pgtype.Text{String: restaurant.Timezone, Valid: true}
+	}
+
+	dbRestaurant, err := r.q(ctx).UpdateRestaurantProfile(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("restaurant repository: %w", domain.ErrVersionMismatch.Wrap(err))
+		}
+		if isUniqueViolation(err, constraintRestaurantSlug) {
+			return fmt.Errorf("restaurant repository: %w", domain.ErrSlugAlreadyExists.Wrap(err))
+		}
+		return fmt.Errorf("restaurant repository: update profile: %w", err)
+	}
+
+	restaurant.Version = int(dbRestaurant.Version)
+	restaurant.UpdatedAt = dbRestaurant.UpdatedAt.Time
 	return nil
 }
 
@@ -186,14 +301,14 @@ func (r *RestaurantRepository) CreateAddress(ctx context.Context, address *domai
 		City:         address.City,
 		State:        address.State,
 		ZipCode:      address.ZipCode,
-		Lat:          address.Lat,
-		Lng:          address.Lng,
+		Lat:          toFloat8(address.Lat),
+		Lng:          toFloat8(address.Lng),
 	}
 	if address.Complement != "" {
 		params.Complement = pgtype.Text{String: address.Complement, Valid: true}
 	}
 
-	dbAddress, err := r.queries.CreateRestaurantAddress(ctx, params)
+	dbAddress, err := r.q(ctx).CreateRestaurantAddress(ctx, params)
 	if err != nil {
 		return fmt.Errorf("restaurant repository: create address: %w", err)
 	}
@@ -211,14 +326,14 @@ func (r *RestaurantRepository) UpdateAddress(ctx context.Context, address *domai
 		City:         address.City,
 		State:        address.State,
 		ZipCode:      address.ZipCode,
-		Lat:          address.Lat,
-		Lng:          address.Lng,
+		Lat:          toFloat8(address.Lat),
+		Lng:          toFloat8(address.Lng),
 	}
 	if address.Complement != "" {
 		params.Complement = pgtype.Text{String: address.Complement, Valid: true}
 	}
 
-	dbAddress, err := r.queries.UpdateRestaurantAddress(ctx, params)
+	dbAddress, err := r.q(ctx).UpdateRestaurantAddress(ctx, params)
 	if err != nil {
 		return fmt.Errorf("restaurant repository: update address: %w", err)
 	}
@@ -228,32 +343,31 @@ func (r *RestaurantRepository) UpdateAddress(ctx context.Context, address *domai
 }
 
 // GetAddress busca o endereço de um restaurante
+// Retorna nil se o restaurante não tiver endereço
 func (r *RestaurantRepository) GetAddress(ctx context.Context, restaurantID uuid.UUID) (*domain.Address, error) {
-	dbAddress, err := r.queries.GetRestaurantAddress(ctx, restaurantID)
+	dbAddress, err := r.getAddressRow(ctx, restaurantID)
+	if err != nil || dbAddress == nil {
+		return nil, err
+	}
+
+	return addressToDomain(dbAddress), nil
+}
+
+// getAddressRow busca a linha de endereço; retorna nil se não existir
+func (r *RestaurantRepository) getAddressRow(ctx context.Context, restaurantID uuid.UUID) (*database.RestaurantAddress, error) {
+	dbAddress, err := r.q(ctx).GetRestaurantAddress(ctx, restaurantID)
 	if err != nil {
-		if err == pgx.ErrNoRows {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, fmt.Errorf("restaurant repository: get address: %w", err)
 	}
-
-	return &domain.Address{
-		ID:           dbAddress.ID,
-		RestaurantID: dbAddress.RestaurantID,
-		Street:       dbAddress.Street,
-		Number:       dbAddress.Number,
-		Complement:   dbAddress.Complement.String,
-		City:         dbAddress.City,
-		State:        dbAddress.State,
-		ZipCode:      dbAddress.ZipCode,
-		Lat:          dbAddress.Lat,
-		Lng:          dbAddress.Lng,
-	}, nil
+	return &dbAddress, nil
 }
 
 // CreateOpeningHour cria um horário de funcionamento
 func (r *RestaurantRepository) CreateOpeningHour(ctx context.Context, hour *domain.OpeningHour) error {
-	dbHour, err := r.queries.CreateOpeningHour(ctx, database.CreateOpeningHourParams{
+	dbHour, err := r.q(ctx).CreateOpeningHour(ctx, database.CreateOpeningHourParams{
 		RestaurantID: hour.RestaurantID,
 		Weekday:      int32(hour.Weekday),
 		OpensAt:      int32(hour.OpensAt),
@@ -269,7 +383,7 @@ func (r *RestaurantRepository) CreateOpeningHour(ctx context.Context, hour *doma
 
 // DeleteOpeningHoursByRestaurant remove todos os horários de um restaurante
 func (r *RestaurantRepository) DeleteOpeningHoursByRestaurant(ctx context.Context, restaurantID uuid.UUID) error {
-	err := r.queries.DeleteOpeningHoursByRestaurant(ctx, restaurantID)
+	err := r.q(ctx).DeleteOpeningHoursByRestaurant(ctx, restaurantID)
 	if err != nil {
 		return fmt.Errorf("restaurant repository: delete opening hours: %w", err)
 	}
@@ -278,7 +392,7 @@ func (r *RestaurantRepository) DeleteOpeningHoursByRestaurant(ctx context.Contex
 
 // GetOpeningHours busca os horários de funcionamento de um restaurante
 func (r *RestaurantRepository) GetOpeningHours(ctx context.Context, restaurantID uuid.UUID) ([]*domain.OpeningHour, error) {
-	dbHours, err := r.queries.GetOpeningHoursByRestaurant(ctx, restaurantID)
+	dbHours, err := r.q(ctx).GetOpeningHoursByRestaurant(ctx, restaurantID)
 	if err != nil {
 		return nil, fmt.Errorf("restaurant repository: get opening hours: %w", err)
 	}
@@ -299,7 +413,7 @@ func (r *RestaurantRepository) GetOpeningHours(ctx context.Context, restaurantID
 
 // CreatePaymentMethod cria um método de pagamento
 func (r *RestaurantRepository) CreatePaymentMethod(ctx context.Context, method *domain.PaymentMethod) error {
-	dbMethod, err := r.queries.CreatePaymentMethod(ctx, database.CreatePaymentMethodParams{
+	dbMethod, err := r.q(ctx).CreatePaymentMethod(ctx, database.CreatePaymentMethodParams{
 		RestaurantID: method.RestaurantID,
 		Method:       method.Method,
 	})
@@ -313,7 +427,7 @@ func (r *RestaurantRepository) CreatePaymentMethod(ctx context.Context, method *
 
 // DeletePaymentMethodsByRestaurant remove todos os métodos de pagamento de um restaurante
 func (r *RestaurantRepository) DeletePaymentMethodsByRestaurant(ctx context.Context, restaurantID uuid.UUID) error {
-	err := r.queries.DeletePaymentMethodsByRestaurant(ctx, restaurantID)
+	err := r.q(ctx).DeletePaymentMethodsByRestaurant(ctx, restaurantID)
 	if err != nil {
 		return fmt.Errorf("restaurant repository: delete payment methods: %w", err)
 	}
@@ -322,7 +436,7 @@ func (r *RestaurantRepository) DeletePaymentMethodsByRestaurant(ctx context.Cont
 
 // GetPaymentMethods busca os métodos de pagamento de um restaurante
 func (r *RestaurantRepository) GetPaymentMethods(ctx context.Context, restaurantID uuid.UUID) ([]*domain.PaymentMethod, error) {
-	dbMethods, err := r.queries.GetPaymentMethodsByRestaurant(ctx, restaurantID)
+	dbMethods, err := r.q(ctx).GetPaymentMethodsByRestaurant(ctx, restaurantID)
 	if err != nil {
 		return nil, fmt.Errorf("restaurant repository: get payment methods: %w", err)
 	}
@@ -358,6 +472,7 @@ func (r *RestaurantRepository) toDomain(
 		PreparationTimeMin: int(dbRestaurant.PreparationTimeMin),
 		SupportsPickup:     dbRestaurant.SupportsPickup,
 		SupportsDelivery:   dbRestaurant.SupportsDelivery,
+		Version:            int(dbRestaurant.Version),
 		CreatedAt:          dbRestaurant.CreatedAt.Time,
 		UpdatedAt:          dbRestaurant.UpdatedAt.Time,
 	}
@@ -368,29 +483,22 @@ func (r *RestaurantRepository) toDomain(
 	if dbRestaurant.Category.Valid {
 		restaurant.Category = dbRestaurant.Category.String
 	}
+	if dbRestaurant.StatusReason.Valid {
+		restaurant.StatusReason = dbRestaurant.StatusReason.String
+	}
 	if dbRestaurant.LogoUrl.Valid {
 		restaurant.LogoURL = dbRestaurant.LogoUrl.String
 	}
 	if dbRestaurant.BannerUrl.Valid {
 		restaurant.BannerURL = dbRestaurant.BannerUrl.String
 	}
+	if dbRestaurant.Timezone.Valid {
+		restaurant.Timezone = dbRestaurant.Timezone.String
+	}
 
 	// Converter endereço
 	if dbAddress != nil {
-		restaurant.Address = &domain.Address{
-			ID:           dbAddress.ID,
-			RestaurantID: dbAddress.RestaurantID,
-			Street:       dbAddress.Street,
-			Number:       dbAddress.Number,
-			City:         dbAddress.City,
-			State:        dbAddress.State,
-			ZipCode:      dbAddress.ZipCode,
-			Lat:          dbAddress.Lat,
-			Lng:          dbAddress.Lng,
-		}
-		if dbAddress.Complement.Valid {
-			restaurant.Address.Complement = dbAddress.Complement.String
-		}
+		restaurant.Address = addressToDomain(dbAddress)
 	}
 
 	// Converter horários
@@ -418,6 +526,22 @@ func (r *RestaurantRepository) toDomain(
 	return restaurant, nil
 }
 
+// addressToDomain converte o endereço do banco para entidade de domínio
+func addressToDomain(dbAddress *database.RestaurantAddress) *domain.Address {
+	return &domain.Address{
+		ID:           dbAddress.ID,
+		RestaurantID: dbAddress.RestaurantID,
+		Street:       dbAddress.Street,
+		Number:       dbAddress.Number,
+		Complement:   dbAddress.Complement.String,
+		City:         dbAddress.City,
+		State:        dbAddress.State,
+		ZipCode:      dbAddress.ZipCode,
+		Lat:          fromFloat8(dbAddress.Lat),
+		Lng:          fromFloat8(dbAddress.Lng),
+	}
+}
+
 // WithTx retorna um repository com transação
 func (r *RestaurantRepository) WithTx(tx pgx.Tx) *RestaurantRepository {
 	return &RestaurantRepository{
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"gastro-go/internal/database"
+	"gastro-go/internal/domain"
+)
+
+// loadAggregate carrega os relacionamentos de um restaurante e monta o aggregate
+func (r *RestaurantRepository) loadAggregate(ctx context.Context, dbRestaurant *database.Restaurant) (*domain.Restaurant, error) {
+	restaurants, err := r.loadAggregates(ctx, []database.Restaurant{*dbRestaurant})
+	if err != nil {
+		return nil, err
+	}
+	return restaurants[0], nil
+}
+
+// loadAggregates monta os aggregates de uma página de restaurantes, preservando a ordem
+// Cada relacionamento é buscado com uma única consulta (restaurant_id = ANY($1)) e
+// distribuído em memória: o custo é fixo por página, independente do número de restaurantes
+func (r *RestaurantRepository) loadAggregates(ctx context.Context, dbRestaurants []database.Restaurant) ([]*domain.Restaurant, error) {
+	restaurants := make([]*domain.Restaurant, 0, len(dbRestaurants))
+	if len(dbRestaurants) == 0 {
+		return restaurants, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(dbRestaurants))
+	for _, dbRestaurant := range dbRestaurants {
+		ids = append(ids, dbRestaurant.ID)
+	}
+
+	dbAddresses, err := r.q(ctx).GetRestaurantAddressesByRestaurants(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: load addresses: %w", err)
+	}
+	dbHours, err := r.q(ctx).GetOpeningHoursByRestaurants(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: load opening hours: %w", err)
+	}
+	dbMethods, err := r.q(ctx).GetPaymentMethodsByRestaurants(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: load payment methods: %w", err)
+	}
+
+	// A janela começa um dia antes de hoje (UTC) para cobrir restaurantes em fusos atrasados
+	from := time.Now().UTC().AddDate(0, 0, -1)
+	to := from.AddDate(0, 0, specialHoursWindow+1)
+	dbSpecials, err := r.q(ctx).ListSpecialHoursByRestaurants(ctx, database.ListSpecialHoursByRestaurantsParams{
+		RestaurantIds: ids,
+		FromDate:      pgtype.Date{Time: from, Valid: true},
+		ToDate:        pgtype.Date{Time: to, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: load special hours: %w", err)
+	}
+	dbAreas, err := r.q(ctx).GetDeliveryAreasByRestaurants(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: load delivery areas: %w", err)
+	}
+	dbCategories, err := r.q(ctx).GetCategoriesByRestaurants(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: load categories: %w", err)
+	}
+
+	addresses := make(map[uuid.UUID]*database.RestaurantAddress, len(dbAddresses))
+	for i := range dbAddresses {
+		addresses[dbAddresses[i].RestaurantID] = &dbAddresses[i]
+	}
+	hours := groupByRestaurant(dbHours, func(row database.RestaurantOpeningHour) uuid.UUID { return row.RestaurantID })
+	methods := groupByRestaurant(dbMethods, func(row database.RestaurantPaymentMethod) uuid.UUID { return row.RestaurantID })
+	specials := groupByRestaurant(dbSpecials, func(row database.RestaurantSpecialHour) uuid.UUID { return row.RestaurantID })
+	areas := groupByRestaurant(dbAreas, func(row database.RestaurantDeliveryArea) uuid.UUID { return row.RestaurantID })
+	categories := groupByRestaurant(dbCategories, func(row database.GetCategoriesByRestaurantsRow) uuid.UUID { return row.RestaurantID })
+
+	for i := range dbRestaurants {
+		id := dbRestaurants[i].ID
+		restaurant, err := r.toDomain(&dbRestaurants[i], addresses[id], hours[id], methods[id])
+		if err != nil {
+			return nil, err
+		}
+		restaurant.SpecialHours = specialHoursToDomain(specials[id])
+		restaurant.Categories = make([]domain.Category, 0, len(categories[id]))
+		for _, row := range categories[id] {
+			restaurant.Categories = append(restaurant.Categories, categoryToDomain(row.Category))
+		}
+		if restaurant.DeliveryAreas, err = deliveryAreasToDomain(areas[id]); err != nil {
+			return nil, err
+		}
+		restaurants = append(restaurants, restaurant)
+	}
+
+	return restaurants, nil
+}
+
+// groupByRestaurant agrupa linhas pelo restaurante, mantendo a ordem retornada pelo banco
+func groupByRestaurant[T any](rows []T, restaurantID func(T) uuid.UUID) map[uuid.UUID][]T {
+	grouped := make(map[uuid.UUID][]T)
+	for _, row := range rows {
+		id := restaurantID(row)
+		grouped[id] = append(grouped[id], row)
+	}
+	return grouped
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gastro-go/internal/database"
+	"gastro-go/internal/domain"
+)
+
+// countingDB implementa database.DBTX contando as consultas enviadas ao banco
+// ListRestaurants devolve `restaurants` linhas (apenas o ID preenchido); as demais, nenhuma
+type countingDB struct {
+	restaurants int
+	queries     int
+}
+
+func (db *countingDB) Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error) {
+	db.queries++
+	return pgconn.CommandTag{}, nil
+}
+
+func (db *countingDB) Query(_ context.Context, sql string, _ ...interface{}) (pgx.Rows, error) {
+	db.queries++
+	if strings.HasPrefix(sql, "-- name: ListRestaurants ") {
+		return &fakeRows{remaining: db.restaurants}, nil
+	}
+	return &fakeRows{}, nil
+}
+
+func (db *countingDB) QueryRow(context.Context, string, ...interface{}) pgx.Row {
+	db.queries++
+	return noRow{}
+}
+
+// noRow é um pgx.Row vazio
+type noRow struct{}
+
+func (noRow) Scan(...any) error { return pgx.ErrNoRows }
+
+// fakeRows é um pgx.Rows em memória que preenche apenas a primeira coluna (id)
+type fakeRows struct {
+	remaining int
+}
+
+func (r *fakeRows) Close()                                       {}
+func (r *fakeRows) Err() error                                   { return nil }
+func (r *fakeRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *fakeRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *fakeRows) Values() ([]any, error)                       { return nil, nil }
+func (r *fakeRows) RawValues() [][]byte                          { return nil }
+func (r *fakeRows) Conn() *pgx.Conn                              { return nil }
+
+func (r *fakeRows) Next() bool {
+	if r.remaining == 0 {
+		return false
+	}
+	r.remaining--
+	return true
+}
+
+func (r *fakeRows) Scan(dest ...any) error {
+	*dest[0].(*uuid.UUID) = uuid.New()
+	return nil
+}
+
+func TestRestaurantRepository_List_QueryCountIsConstant(t *testing.T) {
+	for _, size := range []int{1, 20, 100} {
+		t.Run(fmt.Sprintf("page=%d", size), func(t *testing.T) {
+			// Input
+			db := &countingDB{restaurants: size}
+			repo := NewRestaurantRepository(database.New(db))
+
+			// Output
+			restaurants, err := repo.List(context.Background(), domain.RestaurantFilter{}, domain.RestaurantSort{}, nil, int32(size), 0)
+
+			// Assert: 1 listagem + endereços, horários, pagamentos, exceções, áreas de entrega e categorias
+			require.NoError(t, err)
+			assert.Len(t, restaurants, size)
+			assert.Equal(t, 7, db.queries)
+		})
+	}
+}
+
+func BenchmarkRestaurantRepository_List(b *testing.B) {
+	for _, size := range []int{1, 20, 100} {
+		b.Run(fmt.Sprintf("page=%d", size), func(b *testing.B) {
+			db := &countingDB{restaurants: size}
+			repo := NewRestaurantRepository(database.New(db))
+			ctx := context.Background()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := repo.List(ctx, domain.RestaurantFilter{}, domain.RestaurantSort{}, nil, int32(size), 0); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(db.queries)/float64(b.N), "queries/op")
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"gastro-go/internal/database"
+	"gastro-go/internal/domain"
+)
+
+// CreateDeliveryArea cria uma área de entrega
+// O polígono é gravado como GeoJSON (JSONB)
+func (r *RestaurantRepository) CreateDeliveryArea(ctx context.Context, area *domain.DeliveryArea) error {
+	params := database.CreateDeliveryAreaParams{
+		RestaurantID: area.RestaurantID,
+		Kind:         area.Kind,
+	}
+	if area.RadiusMeters > 0 {
+		params.RadiusM = pgtype.Int4{Int32: int32(area.RadiusMeters), Valid: true}
+	}
+	if area.Polygon != nil {
+		polygon, err := json.Marshal(area.Polygon)
+		if err != nil {
+			return fmt.Errorf("restaurant repository: create delivery area: %w", err)
+		}
+		params.Polygon = polygon
+	}
+
+	dbArea, err := r.q(ctx).CreateDeliveryArea(ctx, params)
+	if err != nil {
+		return fmt.Errorf("restaurant repository: create delivery area: %w", err)
+	}
+
+	area.ID = dbArea.ID
+	return nil
+}
+
+// DeleteDeliveryAreasByRestaurant remove todas as áreas de entrega de um restaurante
+func (r *RestaurantRepository) DeleteDeliveryAreasByRestaurant(ctx context.Context, restaurantID uuid.UUID) error {
+	if err := r.q(ctx).DeleteDeliveryAreasByRestaurant(ctx, restaurantID); err != nil {
+		return fmt.Errorf("restaurant repository: delete delivery areas: %w", err)
+	}
+	return nil
+}
+
+// GetDeliveryAreas busca as áreas de entrega de um restaurante
+func (r *RestaurantRepository) GetDeliveryAreas(ctx context.Context, restaurantID uuid.UUID) ([]domain.DeliveryArea, error) {
+	dbAreas, err := r.q(ctx).GetDeliveryAreasByRestaurant(ctx, restaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: get delivery areas: %w", err)
+	}
+
+	return deliveryAreasToDomain(dbAreas)
+}
+
+// deliveryAreasToDomain converte as áreas do banco para entidades de domínio
+func deliveryAreasToDomain(dbAreas []database.RestaurantDeliveryArea) ([]domain.DeliveryArea, error) {
+	areas := make([]domain.DeliveryArea, 0, len(dbAreas))
+	for _, dbArea := range dbAreas {
+		area, err := deliveryAreaToDomain(dbArea)
+		if err != nil {
+			return nil, err
+		}
+		areas = append(areas, area)
+	}
+	return areas, nil
+}
+
+// deliveryAreaToDomain converte a área do banco para entidade de domínio
+func deliveryAreaToDomain(dbArea database.RestaurantDeliveryArea) (domain.DeliveryArea, error) {
+	area := domain.DeliveryArea{
+		ID:           dbArea.ID,
+		RestaurantID: dbArea.RestaurantID,
+		Kind:         dbArea.Kind,
+		RadiusMeters: int(dbArea.RadiusM.Int32),
+	}
+	if len(dbArea.Polygon) > 0 {
+		area.Polygon = &domain.GeoJSONPolygon{}
+		if err := json.Unmarshal(dbArea.Polygon, area.Polygon); err != nil {
+			return area, fmt.Errorf("restaurant repository: decode delivery area polygon: %w", err)
+		}
+	}
+	return area, nil
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"gastro-go/internal/database"
+	"gastro-go/internal/domain"
+)
+
+// CountByCategory conta os restaurantes por categoria da taxonomia sob os filtros (exceto os computados)
+func (r *RestaurantRepository) CountByCategory(ctx context.Context, filter domain.RestaurantFilter) ([]domain.FacetValue, error) {
+	rows, err := r.q(ctx).CountRestaurantsByCategory(ctx, database.CountRestaurantsByCategoryParams(countParams(filter)))
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: count by category: %w", err)
+	}
+
+	values := make([]domain.FacetValue, 0, len(rows))
+	for _, row := range rows {
+		values = append(values, domain.FacetValue{Value: row.Value, Label: row.Label, Count: row.Count})
+	}
+	return values, nil
+}
+
+// CountByCity conta os restaurantes por cidade do endereço sob os filtros (exceto os computados)
+func (r *RestaurantRepository) CountByCity(ctx context.Context, filter domain.RestaurantFilter) ([]domain.FacetValue, error) {
+	rows, err := r.q(ctx).CountRestaurantsByCity(ctx, database.CountRestaurantsByCityParams(countParams(filter)))
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: count by city: %w", err)
+	}
+
+	values := make([]domain.FacetValue, 0, len(rows))
+	for _, row := range rows {
+		values = append(values, domain.FacetValue{Value: row.Value, Count: row.Count})
+	}
+	return values, nil
+}
+
+// CountByPaymentMethod conta os restaurantes por método de pagamento sob os filtros (exceto os computados)
+func (r *RestaurantRepository) CountByPaymentMethod(ctx context.Context, filter domain.RestaurantFilter) ([]domain.FacetValue, error) {
+	rows, err := r.q(ctx).CountRestaurantsByPaymentMethod(ctx, database.CountRestaurantsByPaymentMethodParams(countParams(filter)))
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: count by payment method: %w", err)
+	}
+
+	values := make([]domain.FacetValue, 0, len(rows))
+	for _, row := range rows {
+		values = append(values, domain.FacetValue{Value: row.Value, Count: row.Count})
+	}
+	return values, nil
+}
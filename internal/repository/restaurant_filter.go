@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"gastro-go/internal/database"
+	"gastro-go/internal/domain"
+)
+
+// listParams converte filtro e cursor de domínio nos parâmetros da query de listagem
+// Filtros ausentes viram NULL e desativam o predicado correspondente
+func listParams(filter domain.RestaurantFilter, cursor *domain.ListCursor, limit, offset int32) database.ListRestaurantsParams {
+	count := countParams(filter)
+	params := database.ListRestaurantsParams{
+		Category:           count.Category,
+		Status:             count.Status,
+		SupportsDelivery:   count.SupportsDelivery,
+		SupportsPickup:     count.SupportsPickup,
+		MaxDeliveryFee:     count.MaxDeliveryFee,
+		MaxPreparationTime: count.MaxPreparationTime,
+		City:               count.City,
+		State:              count.State,
+		HasDeliveryArea:    count.HasDeliveryArea,
+		OpenNow:            count.OpenNow,
+		Limit:              limit,
+		Offset:             offset,
+	}
+
+	if cursor != nil {
+		params.CursorCreatedAt = pgtype.Timestamp{Time: cursor.CreatedAt, Valid: true}
+		params.CursorID = pgtype.UUID{Bytes: cursor.ID, Valid: true}
+	}
+
+	return params
+}
+
+// searchParams converte filtro e cursor de domínio nos parâmetros da busca textual
+// O rank do cursor só é usado na busca; fora dela a ordenação é apenas (created_at, id)
+func searchParams(filter domain.RestaurantFilter, cursor *domain.ListCursor, limit, offset int32) database.SearchRestaurantsParams {
+	list := listParams(filter, cursor, limit, offset)
+	params := database.SearchRestaurantsParams{
+		Query:              filter.Query,
+		Category:           list.Category,
+		Status:             list.Status,
+		SupportsDelivery:   list.SupportsDelivery,
+		SupportsPickup:     list.SupportsPickup,
+		MaxDeliveryFee:     list.MaxDeliveryFee,
+		MaxPreparationTime: list.MaxPreparationTime,
+		City:               list.City,
+		State:              list.State,
+		HasDeliveryArea:    list.HasDeliveryArea,
+		OpenNow:            list.OpenNow,
+		CursorCreatedAt:    list.CursorCreatedAt,
+		CursorID:           list.CursorID,
+		Limit:              limit,
+		Offset:             offset,
+	}
+
+	if cursor != nil {
+		params.CursorRank = pgtype.Float4{Float32: cursor.Rank, Valid: true}
+	}
+
+	return params
+}
+
+// sortedParams converte filtro, ordenação e cursor de domínio nos parâmetros da listagem com sort
+// As chaves do cursor são as mesmas calculadas por domain.CursorFor para a ordenação informada
+func sortedParams(filter domain.RestaurantFilter, sort domain.RestaurantSort, cursor *domain.ListCursor, limit, offset int32) database.ListRestaurantsSortedParams {
+	count := countParams(filter)
+	params := database.ListRestaurantsSortedParams{
+		Sort:               sort.String(),
+		Category:           count.Category,
+		Status:             count.Status,
+		SupportsDelivery:   count.SupportsDelivery,
+		SupportsPickup:     count.SupportsPickup,
+		MaxDeliveryFee:     count.MaxDeliveryFee,
+		MaxPreparationTime: count.MaxPreparationTime,
+		City:               count.City,
+		State:              count.State,
+		HasDeliveryArea:    count.HasDeliveryArea,
+		Query:              count.Query,
+		OpenNow:            count.OpenNow,
+		Limit:              limit,
+		Offset:             offset,
+	}
+
+	if sort.Origin != nil {
+		params.OriginLat = pgtype.Float8{Float64: sort.Origin.Lat, Valid: true}
+		params.OriginLng = pgtype.Float8{Float64: sort.Origin.Lng, Valid: true}
+	}
+	if cursor != nil {
+		params.CursorID = pgtype.UUID{Bytes: cursor.ID, Valid: true}
+		params.CursorNumber = pgtype.Float8{Float64: cursor.SortNumber, Valid: true}
+		if cursor.SortText != nil {
+			params.CursorText = pgtype.Text{String: *cursor.SortText, Valid: true}
+		}
+	}
+
+	return params
+}
+
+// countParams converte o filtro de domínio nos parâmetros da query de contagem
+func countParams(filter domain.RestaurantFilter) database.CountRestaurantsParams {
+	params := database.CountRestaurantsParams{
+		Category: toText(filter.Category),
+		Status:   toText(filter.Status),
+		City:     toText(filter.City),
+		State:    toText(filter.State),
+		Query:    toText(filter.Query),
+	}
+
+	if filter.SupportsDelivery != nil {
+		params.SupportsDelivery = pgtype.Bool{Bool: *filter.SupportsDelivery, Valid: true}
+	}
+	if filter.SupportsPickup != nil {
+		params.SupportsPickup = pgtype.Bool{Bool: *filter.SupportsPickup, Valid: true}
+	}
+	if filter.MaxDeliveryFee != nil {
+		params.MaxDeliveryFee = pgtype.Int8{Int64: *filter.MaxDeliveryFee, Valid: true}
+	}
+	if filter.MaxPreparationTimeMin != nil {
+		params.MaxPreparationTime = pgtype.Int4{Int32: int32(*filter.MaxPreparationTimeMin), Valid: true}
+	}
+	// Avaliado pelo banco com o horário atual, no fuso de cada restaurante
+	if filter.OpenNow {
+		params.OpenNow = pgtype.Bool{Bool: true, Valid: true}
+	}
+	// Pré-filtro de DeliversTo: o ponto é avaliado contra as áreas pelo use case
+	if filter.DeliversTo != nil {
+		params.HasDeliveryArea = pgtype.Bool{Bool: true, Valid: true}
+	}
+
+	return params
+}
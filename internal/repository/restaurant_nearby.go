@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"gastro-go/internal/database"
+	"gastro-go/internal/domain"
+)
+
+// ListNearby lista restaurantes dentro do raio da busca, do mais próximo para o mais distante
+// Restaurantes sem coordenadas nunca aparecem. DistanceMeters vem preenchido em cada item
+// OpenNow mantém apenas restaurantes OPEN dentro de um intervalo no próprio fuso
+func (r *RestaurantRepository) ListNearby(ctx context.Context, search domain.NearbySearch, limit int32) ([]*domain.Restaurant, error) {
+	box := domain.BoundingBoxAround(search.Lat, search.Lng, search.RadiusMeters())
+	rows, err := r.q(ctx).ListNearbyRestaurants(ctx, database.ListNearbyRestaurantsParams{
+		Lat:     search.Lat,
+		Lng:     search.Lng,
+		MinLat:  box.MinLat,
+		MaxLat:  box.MaxLat,
+		MinLng:  box.MinLng,
+		MaxLng:  box.MaxLng,
+		RadiusM: search.RadiusMeters(),
+		OpenNow: search.OpenNow,
+		Limit:   limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: list nearby: %w", err)
+	}
+
+	dbRestaurants := make([]database.Restaurant, 0, len(rows))
+	for _, row := range rows {
+		dbRestaurants = append(dbRestaurants, row.Restaurant)
+	}
+	restaurants, err := r.loadAggregates(ctx, dbRestaurants)
+	if err != nil {
+		return nil, err
+	}
+	for i := range restaurants {
+		distance := rows[i].DistanceM
+		restaurants[i].DistanceMeters = &distance
+	}
+
+	return restaurants, nil
+}
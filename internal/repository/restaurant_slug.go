@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"gastro-go/internal/database"
+	"gastro-go/internal/domain"
+)
+
+// SlugExists verifica se o slug pertence a outro restaurante (uuid.Nil na criação)
+// Slugs aposentados continuam reservados para o restaurante que os usou
+func (r *RestaurantRepository) SlugExists(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error) {
+	exists, err := r.q(ctx).RestaurantSlugExists(ctx, database.RestaurantSlugExistsParams{Slug: slug, ID: excludeID})
+	if err != nil {
+		return false, fmt.Errorf("restaurant repository: check slug exists: %w", err)
+	}
+	return exists, nil
+}
+
+// ListTakenSlugs lista os slugs atuais e aposentados que são a base ou base-N
+func (r *RestaurantRepository) ListTakenSlugs(ctx context.Context, base string) ([]string, error) {
+	slugs, err := r.q(ctx).ListTakenSlugs(ctx, base)
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: list taken slugs: %w", err)
+	}
+	return slugs, nil
+}
+
+// GetCanonicalSlug resolve um slug aposentado para o slug atual do restaurante
+func (r *RestaurantRepository) GetCanonicalSlug(ctx context.Context, slug string) (string, error) {
+	canonical, err := r.q(ctx).GetCanonicalSlug(ctx, slug)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", fmt.Errorf("restaurant repository: %w", domain.ErrRestaurantNotFound.Wrap(err))
+		}
+		return "", fmt.Errorf("restaurant repository: get canonical slug: %w", err)
+	}
+	return canonical, nil
+}
+
+// RetireSlug registra o slug antigo no histórico ao trocar para newSlug
+// Se newSlug já foi usado pelo próprio restaurante, ele sai do histórico
+func (r *RestaurantRepository) RetireSlug(ctx context.Context, restaurantID uuid.UUID, oldSlug, newSlug string) error {
+	err := r.q(ctx).DeleteRestaurantSlugHistory(ctx, database.DeleteRestaurantSlugHistoryParams{
+		Slug:         newSlug,
+		RestaurantID: restaurantID,
+	})
+	if err != nil {
+		return fmt.Errorf("restaurant repository: release slug: %w", err)
+	}
+
+	err = r.q(ctx).AddRestaurantSlugHistory(ctx, database.AddRestaurantSlugHistoryParams{
+		Slug:         oldSlug,
+		RestaurantID: restaurantID,
+	})
+	if err != nil {
+		if isUniqueViolation(err, constraintRestaurantSlugHistory) {
+			return fmt.Errorf("restaurant repository: %w", domain.ErrSlugAlreadyExists.Wrap(err))
+		}
+		return fmt.Errorf("restaurant repository: retire slug: %w", err)
+	}
+	return nil
+}
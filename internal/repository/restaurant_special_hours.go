@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"gastro-go/internal/database"
+	"gastro-go/internal/domain"
+)
+
+// specialHoursWindow é quantos dias de exceções futuras são carregados com o aggregate
+const specialHoursWindow = 14
+
+// CreateSpecialHours grava a exceção de uma data (uma linha por intervalo)
+// Deve ser chamado dentro de TxRunner.RunInTx junto com DeleteSpecialHours para substituir a data
+func (r *RestaurantRepository) CreateSpecialHours(ctx context.Context, special *domain.SpecialHours) error {
+	date, err := toDate(special.Date)
+	if err != nil {
+		return fmt.Errorf("restaurant repository: create special hours: %w", err)
+	}
+
+	params := database.CreateSpecialHourParams{
+		RestaurantID: special.RestaurantID,
+		Date:         date,
+	}
+	if special.Note != "" {
+		params.Note = pgtype.Text{String: special.Note, Valid: true}
+	}
+
+	// Dia fechado é uma única linha sem horários
+	if special.Closed {
+		if _, err := r.q(ctx).CreateSpecialHour(ctx, params); err != nil {
+			return fmt.Errorf("restaurant repository: create special hours: %w", err)
+		}
+		return nil
+	}
+
+	for _, interval := range special.Intervals {
+		params.OpensAt = pgtype.Int4{Int32: int32(interval.OpensAt), Valid: true}
+		params.ClosesAt = pgtype.Int4{Int32: int32(interval.ClosesAt), Valid: true}
+		if _, err := r.q(ctx).CreateSpecialHour(ctx, params); err != nil {
+			return fmt.Errorf("restaurant repository: create special hours: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// DeleteSpecialHours remove a exceção de uma data
+// Retorna a quantidade de linhas removidas (0 se a data não tinha exceção)
+func (r *RestaurantRepository) DeleteSpecialHours(ctx context.Context, restaurantID uuid.UUID, date string) (int64, error) {
+	pgDate, err := toDate(date)
+	if err != nil {
+		return 0, fmt.Errorf("restaurant repository: delete special hours: %w", err)
+	}
+
+	deleted, err := r.q(ctx).DeleteSpecialHoursByDate(ctx, database.DeleteSpecialHoursByDateParams{
+		RestaurantID: restaurantID,
+		Date:         pgDate,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("restaurant repository: delete special hours: %w", err)
+	}
+	return deleted, nil
+}
+
+// ListSpecialHours lista as exceções entre as datas from e to (inclusive)
+func (r *RestaurantRepository) ListSpecialHours(ctx context.Context, restaurantID uuid.UUID, from, to string) ([]*domain.SpecialHours, error) {
+	fromDate, err := toDate(from)
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: list special hours: %w", err)
+	}
+	toDateValue, err := toDate(to)
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: list special hours: %w", err)
+	}
+
+	dbRows, err := r.q(ctx).ListSpecialHoursByRestaurant(ctx, database.ListSpecialHoursByRestaurantParams{
+		RestaurantID: restaurantID,
+		FromDate:     fromDate,
+		ToDate:       toDateValue,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: list special hours: %w", err)
+	}
+
+	grouped := specialHoursToDomain(dbRows)
+	specials := make([]*domain.SpecialHours, 0, len(grouped))
+	for i := range grouped {
+		specials = append(specials, &grouped[i])
+	}
+	return specials, nil
+}
+
+// specialHoursToDomain agrupa as linhas (ordenadas por data) em uma exceção por data
+func specialHoursToDomain(dbRows []database.RestaurantSpecialHour) []domain.SpecialHours {
+	specials := make([]domain.SpecialHours, 0, len(dbRows))
+	for _, dbRow := range dbRows {
+		date := fromDate(dbRow.Date)
+
+		if len(specials) == 0 || specials[len(specials)-1].Date != date {
+			specials = append(specials, domain.SpecialHours{
+				RestaurantID: dbRow.RestaurantID,
+				Date:         date,
+				Closed:       !dbRow.OpensAt.Valid,
+				Intervals:    []domain.TimeInterval{},
+				Note:         dbRow.Note.String,
+			})
+		}
+
+		if dbRow.OpensAt.Valid && dbRow.ClosesAt.Valid {
+			current := &specials[len(specials)-1]
+			current.Intervals = append(current.Intervals, domain.TimeInterval{
+				OpensAt:  int(dbRow.OpensAt.Int32),
+				ClosesAt: int(dbRow.ClosesAt.Int32),
+			})
+		}
+	}
+	return specials
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"gastro-go/internal/database"
+	"gastro-go/internal/domain"
+)
+
+// RecordStatusChange grava uma transição de status no histórico
+func (r *RestaurantRepository) RecordStatusChange(ctx context.Context, change *domain.StatusChange) error {
+	params := database.CreateStatusHistoryEntryParams{
+		RestaurantID: change.RestaurantID,
+		FromStatus:   change.FromStatus,
+		ToStatus:     change.ToStatus,
+		Event:        string(change.Event),
+		ActorRole:    change.Actor.Role,
+	}
+	if change.Reason != "" {
+		params.Reason = pgtype.Text{String: change.Reason, Valid: true}
+	}
+	if change.Actor.ID != "" {
+		params.ActorID = pgtype.Text{String: change.Actor.ID, Valid: true}
+	}
+
+	dbEntry, err := r.q(ctx).CreateStatusHistoryEntry(ctx, params)
+	if err != nil {
+		return fmt.Errorf("restaurant repository: record status change: %w", err)
+	}
+
+	change.ID = dbEntry.ID
+	change.CreatedAt = dbEntry.CreatedAt.Time
+	return nil
+}
+
+// ListStatusHistory lista as transições de status de um restaurante, da mais recente para a mais antiga
+func (r *RestaurantRepository) ListStatusHistory(ctx context.Context, restaurantID uuid.UUID, limit, offset int32) ([]*domain.StatusChange, error) {
+	dbEntries, err := r.q(ctx).ListStatusHistoryByRestaurant(ctx, database.ListStatusHistoryByRestaurantParams{
+		RestaurantID: restaurantID,
+		Limit:        limit,
+		Offset:       offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("restaurant repository: list status history: %w", err)
+	}
+
+	changes := make([]*domain.StatusChange, 0, len(dbEntries))
+	for _, dbEntry := range dbEntries {
+		changes = append(changes, &domain.StatusChange{
+			ID:           dbEntry.ID,
+			RestaurantID: dbEntry.RestaurantID,
+			FromStatus:   dbEntry.FromStatus,
+			ToStatus:     dbEntry.ToStatus,
+			Event:        domain.StatusEvent(dbEntry.Event),
+			Reason:       dbEntry.Reason.String,
+			Actor: domain.Actor{
+				ID:   dbEntry.ActorID.String,
+				Role: dbEntry.ActorRole,
+			},
+			CreatedAt: dbEntry.CreatedAt.Time,
+		})
+	}
+
+	return changes, nil
+}
+
+// CountStatusHistory conta as transições de status de um restaurante
+func (r *RestaurantRepository) CountStatusHistory(ctx context.Context, restaurantID uuid.UUID) (int64, error) {
+	count, err := r.q(ctx).CountStatusHistoryByRestaurant(ctx, restaurantID)
+	if err != nil {
+		return 0, fmt.Errorf("restaurant repository: count status history: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// txKey é a chave usada para propagar a transação corrente pelo context
+type txKey struct{}
+
+// TxRunner executa funções dentro de uma transação do banco (Unit of Work)
+// Repositórios chamados com o ctx recebido pela função participam da mesma transação
+type TxRunner struct {
+	pool *pgxpool.Pool
+}
+
+// NewTxRunner cria uma nova instância do TxRunner
+func NewTxRunner(pool *pgxpool.Pool) *TxRunner {
+	return &TxRunner{
+		pool: pool,
+	}
+}
+
+// RunInTx executa fn dentro de uma transação
+// Faz commit se fn retornar nil e rollback caso contrário (inclusive em panic)
+// Se o ctx já carrega uma transação, fn participa dela em vez de abrir outra
+func (t *TxRunner) RunInTx(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if _, ok := txFromContext(ctx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := t.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("tx runner: begin: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback(ctx)
+			panic(p)
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		if rbErr := tx.Rollback(ctx); rbErr != nil {
+			return errors.Join(err, fmt.Errorf("tx runner: rollback: %w", rbErr))
+		}
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("tx runner: commit: %w", err)
+	}
+
+	return nil
+}
+
+// txFromContext recupera a transação corrente, se houver
+func txFromContext(ctx context.Context) (pgx.Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(pgx.Tx)
+	return tx, ok
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"gastro-go/internal/domain"
+)
+
+// DeliveryChecker define a interface mínima necessária para verificar a entrega em um ponto
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
+type DeliveryChecker interface {
+	GetBySlug(ctx context.Context, slug string) (*domain.Restaurant, error)
+	GetCanonicalSlug(ctx context.Context, slug string) (string, error)
+}
+
+// CheckDeliveryUseCase implementa o caso de uso de verificar se o restaurante entrega em um ponto
+type CheckDeliveryUseCase struct {
+	repo DeliveryChecker
+}
+
+// NewCheckDeliveryUseCase cria uma nova instância do use case
+func NewCheckDeliveryUseCase(repo DeliveryChecker) *CheckDeliveryUseCase {
+	return &CheckDeliveryUseCase{
+		repo: repo,
+	}
+}
+
+// CheckDeliveryInput representa os dados de entrada da verificação
+type CheckDeliveryInput struct {
+	Slug  string
+	Point domain.GeoPoint
+}
+
+// CheckDeliveryOutput representa o resultado da verificação
+// Para um slug aposentado, apenas CanonicalSlug é preenchido, apontando para o slug atual
+type CheckDeliveryOutput struct {
+	RestaurantID   uuid.UUID
+	Delivers       bool
+	DistanceMeters *float64 // Distância do endereço do restaurante, quando há coordenadas
+	CanonicalSlug  string
+}
+
+// Execute verifica se o restaurante entrega no ponto informado
+// Restaurantes em rascunho ou suspensos não são públicos (not found)
+func (uc *CheckDeliveryUseCase) Execute(ctx context.Context, input CheckDeliveryInput) (*CheckDeliveryOutput, error) {
+	if err := input.Point.Validate(); err != nil {
+		return nil, fmt.Errorf("check delivery usecase: %w", err)
+	}
+
+	restaurant, err := uc.repo.GetBySlug(ctx, input.Slug)
+	if errors.Is(err, domain.ErrRestaurantNotFound) {
+		// Mesmo redirecionamento de GET /restaurants/{slug} para slugs aposentados
+		canonical, canonicalErr := uc.repo.GetCanonicalSlug(ctx, input.Slug)
+		if canonicalErr != nil {
+			return nil, fmt.Errorf("check delivery usecase: %w", canonicalErr)
+		}
+		return &CheckDeliveryOutput{CanonicalSlug: canonical}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("check delivery usecase: %w", err)
+	}
+	if !restaurant.IsPublished() {
+		return nil, fmt.Errorf("check delivery usecase: %w", domain.ErrRestaurantNotFound)
+	}
+
+	output := &CheckDeliveryOutput{
+		RestaurantID: restaurant.ID,
+		Delivers:     restaurant.DeliversTo(input.Point),
+	}
+	if restaurant.Address != nil && restaurant.Address.HasCoordinates() {
+		distance := domain.HaversineMeters(*restaurant.Address.Lat, *restaurant.Address.Lng, input.Point.Lat, input.Point.Lng)
+		output.DistanceMeters = &distance
+	}
+
+	return output, nil
+}
@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockDeliveryChecker é um mock específico para DeliveryChecker
+type MockDeliveryChecker struct {
+	mock.Mock
+}
+
+func (m *MockDeliveryChecker) GetBySlug(ctx context.Context, slug string) (*domain.Restaurant, error) {
+	args := m.Called(ctx, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Restaurant), args.Error(1)
+}
+
+func (m *MockDeliveryChecker) GetCanonicalSlug(ctx context.Context, slug string) (string, error) {
+	args := m.Called(ctx, slug)
+	return args.String(0), args.Error(1)
+}
+
+// deliveringRestaurant cria um restaurante com entrega num raio de 2 km da Av. Paulista
+func deliveringRestaurant() *domain.Restaurant {
+	lat, lng := -23.5614, -46.6559
+	return &domain.Restaurant{
+		ID:               uuid.New(),
+		Slug:             "pizzaria-paulista",
+		Status:           domain.StatusOpen,
+		SupportsDelivery: true,
+		Address:          &domain.Address{Lat: &lat, Lng: &lng},
+		DeliveryAreas:    []domain.DeliveryArea{{Kind: domain.DeliveryAreaRadius, RadiusMeters: 2000}},
+	}
+}
+
+func TestCheckDeliveryUseCase_Execute_InsideArea(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurant := deliveringRestaurant()
+	input := CheckDeliveryInput{Slug: "pizzaria-paulista", Point: domain.GeoPoint{Lat: -23.5650, Lng: -46.6600}}
+
+	// Mock
+	mockRepo := new(MockDeliveryChecker)
+	mockRepo.On("GetBySlug", ctx, "pizzaria-paulista").Return(restaurant, nil)
+
+	// Execute
+	uc := NewCheckDeliveryUseCase(mockRepo)
+	output, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, restaurant.ID, output.RestaurantID)
+	assert.True(t, output.Delivers)
+	if assert.NotNil(t, output.DistanceMeters) {
+		assert.InDelta(t, 570, *output.DistanceMeters, 50)
+	}
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCheckDeliveryUseCase_Execute_OutsideArea(t *testing.T) {
+	// Input: Santana, a mais de 8 km da Paulista
+	ctx := context.Background()
+	input := CheckDeliveryInput{Slug: "pizzaria-paulista", Point: domain.GeoPoint{Lat: -23.4990, Lng: -46.6250}}
+
+	// Mock
+	mockRepo := new(MockDeliveryChecker)
+	mockRepo.On("GetBySlug", ctx, "pizzaria-paulista").Return(deliveringRestaurant(), nil)
+
+	// Execute
+	uc := NewCheckDeliveryUseCase(mockRepo)
+	output, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, output.Delivers)
+	assert.NotNil(t, output.DistanceMeters)
+}
+
+func TestCheckDeliveryUseCase_Execute_InvalidPoint(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := CheckDeliveryInput{Slug: "pizzaria-paulista", Point: domain.GeoPoint{Lat: -123, Lng: -46.66}}
+
+	// Mock
+	mockRepo := new(MockDeliveryChecker)
+
+	// Execute
+	uc := NewCheckDeliveryUseCase(mockRepo)
+	output, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	mockRepo.AssertNotCalled(t, "GetBySlug", mock.Anything, mock.Anything)
+}
+
+func TestCheckDeliveryUseCase_Execute_RestaurantNotFound(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := CheckDeliveryInput{Slug: "nao-existe", Point: domain.GeoPoint{Lat: -23.5650, Lng: -46.6600}}
+
+	// Mock
+	mockRepo := new(MockDeliveryChecker)
+	mockRepo.On("GetBySlug", ctx, "nao-existe").Return(nil, domain.ErrRestaurantNotFound)
+	mockRepo.On("GetCanonicalSlug", ctx, "nao-existe").Return("", domain.ErrRestaurantNotFound)
+
+	// Execute
+	uc := NewCheckDeliveryUseCase(mockRepo)
+	output, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, domain.ErrRestaurantNotFound)
+}
+
+func TestCheckDeliveryUseCase_Execute_RetiredSlug(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := CheckDeliveryInput{Slug: "pizza-paulista", Point: domain.GeoPoint{Lat: -23.5650, Lng: -46.6600}}
+
+	// Mock: o slug antigo aponta para o atual
+	mockRepo := new(MockDeliveryChecker)
+	mockRepo.On("GetBySlug", ctx, "pizza-paulista").Return(nil, domain.ErrRestaurantNotFound)
+	mockRepo.On("GetCanonicalSlug", ctx, "pizza-paulista").Return("pizzaria-paulista", nil)
+
+	// Execute
+	uc := NewCheckDeliveryUseCase(mockRepo)
+	output, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "pizzaria-paulista", output.CanonicalSlug)
+	assert.Equal(t, uuid.Nil, output.RestaurantID)
+	assert.False(t, output.Delivers)
+}
+
+func TestCheckDeliveryUseCase_Execute_UnpublishedRestaurant(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := CheckDeliveryInput{Slug: "pizzaria-paulista", Point: domain.GeoPoint{Lat: -23.5650, Lng: -46.6600}}
+	statuses := []string{domain.StatusDraft, domain.StatusSuspended}
+
+	for _, status := range statuses {
+		// Mock
+		restaurant := deliveringRestaurant()
+		restaurant.Status = status
+		mockRepo := new(MockDeliveryChecker)
+		mockRepo.On("GetBySlug", ctx, "pizzaria-paulista").Return(restaurant, nil)
+
+		// Execute
+		uc := NewCheckDeliveryUseCase(mockRepo)
+		output, err := uc.Execute(ctx, input)
+
+		// Assert
+		assert.Nil(t, output, status)
+		assert.ErrorIs(t, err, domain.ErrRestaurantNotFound, status)
+	}
+}
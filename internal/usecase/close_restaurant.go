@@ -12,35 +12,56 @@ import (
 // RestaurantCloser define a interface mínima necessária para fechar restaurantes
 // Segue Interface Segregation Principle: apenas os métodos que este use case precisa
 type RestaurantCloser interface {
-	GetByID(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error)
-	UpdateStatus(ctx context.Context, id uuid.UUID, status string) error
+	GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error)
+	UpdateStatus(ctx context.Context, id uuid.UUID, fromStatus, status, reason string) error
+	RecordStatusChange(ctx context.Context, change *domain.StatusChange) error
 }
 
 // CloseRestaurantUseCase implementa o caso de uso de fechar um restaurante
 type CloseRestaurantUseCase struct {
 	repo RestaurantCloser
+	tx   TxRunner
 }
 
 // NewCloseRestaurantUseCase cria uma nova instância do use case
-func NewCloseRestaurantUseCase(repo RestaurantCloser) *CloseRestaurantUseCase {
+func NewCloseRestaurantUseCase(repo RestaurantCloser, tx TxRunner) *CloseRestaurantUseCase {
 	return &CloseRestaurantUseCase{
 		repo: repo,
+		tx:   tx,
 	}
 }
 
+// CloseRestaurantInput representa os dados de entrada para fechar um restaurante
+type CloseRestaurantInput struct {
+	RestaurantID uuid.UUID
+	Actor        domain.Actor
+}
+
 // Execute executa o caso de uso de fechar restaurante
-func (uc *CloseRestaurantUseCase) Execute(ctx context.Context, id uuid.UUID) error {
-	// Verificar se o restaurante existe
-	_, err := uc.repo.GetByID(ctx, id)
-	if err != nil {
-		return fmt.Errorf("close restaurant usecase: %w", err)
-	}
+func (uc *CloseRestaurantUseCase) Execute(ctx context.Context, input CloseRestaurantInput) error {
+	// Leitura, transição e gravação acontecem na mesma transação, com a linha travada,
+	// para que o from_status registrado no histórico seja o status efetivamente substituído
+	err := uc.tx.RunInTx(ctx, func(ctx context.Context) error {
+		// Verificar se o restaurante existe, travando a linha
+		restaurant, err := uc.repo.GetByIDForUpdate(ctx, input.RestaurantID)
+		if err != nil {
+			return err
+		}
+
+		// Apenas restaurantes OPEN podem ser fechados
+		change, err := restaurant.Transition(domain.EventClose, input.Actor, "")
+		if err != nil {
+			return err
+		}
 
-	// Atualizar status
-	if err := uc.repo.UpdateStatus(ctx, id, domain.StatusClosed); err != nil {
+		if err := uc.repo.UpdateStatus(ctx, restaurant.ID, change.FromStatus, restaurant.Status, restaurant.StatusReason); err != nil {
+			return err
+		}
+		return uc.repo.RecordStatusChange(ctx, change)
+	})
+	if err != nil {
 		return fmt.Errorf("close restaurant usecase: %w", err)
 	}
 
 	return nil
 }
-
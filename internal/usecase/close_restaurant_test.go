@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockRestaurantCloser é um mock específico para RestaurantCloser
+type MockRestaurantCloser struct {
+	mock.Mock
+}
+
+func (m *MockRestaurantCloser) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Restaurant), args.Error(1)
+}
+
+func (m *MockRestaurantCloser) UpdateStatus(ctx context.Context, id uuid.UUID, fromStatus, status, reason string) error {
+	args := m.Called(ctx, id, fromStatus, status, reason)
+	return args.Error(0)
+}
+
+func (m *MockRestaurantCloser) RecordStatusChange(ctx context.Context, change *domain.StatusChange) error {
+	args := m.Called(ctx, change)
+	return args.Error(0)
+}
+
+func TestCloseRestaurantUseCase_Execute_Success(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := CloseRestaurantInput{RestaurantID: restaurantID, Actor: domain.Actor{ID: "owner", Role: domain.RoleMerchant}}
+
+	// Mock
+	mockRepo := new(MockRestaurantCloser)
+	mockRepo.On("GetByIDForUpdate", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID, Status: domain.StatusOpen}, nil)
+	mockRepo.On("UpdateStatus", ctx, restaurantID, domain.StatusOpen, domain.StatusClosed, "").Return(nil)
+	mockRepo.On("RecordStatusChange", ctx, mock.MatchedBy(func(change *domain.StatusChange) bool {
+		return change.FromStatus == domain.StatusOpen &&
+			change.ToStatus == domain.StatusClosed &&
+			change.Event == domain.EventClose &&
+			change.Actor.ID == "owner"
+	})).Return(nil)
+
+	// Execute
+	uc := NewCloseRestaurantUseCase(mockRepo, fakeTxRunner{})
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCloseRestaurantUseCase_Execute_NotOpen(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := CloseRestaurantInput{RestaurantID: restaurantID, Actor: domain.Actor{ID: "owner", Role: domain.RoleMerchant}}
+
+	// Mock
+	mockRepo := new(MockRestaurantCloser)
+	mockRepo.On("GetByIDForUpdate", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID, Status: domain.StatusSuspended}, nil)
+
+	// Execute
+	uc := NewCloseRestaurantUseCase(mockRepo, fakeTxRunner{})
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	var domainErr *domain.Error
+	assert.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "invalid_status_transition", domainErr.Code)
+	mockRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"gastro-go/internal/domain"
+	"gastro-go/internal/utils"
+)
+
+// CategoryCreator define a interface mínima necessária para criar categorias
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
+type CategoryCreator interface {
+	CategorySlugExists(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error)
+	CreateCategory(ctx context.Context, category *domain.Category) error
+}
+
+// CreateCategoryUseCase implementa o caso de uso de criação de categoria (somente admin)
+type CreateCategoryUseCase struct {
+	repo CategoryCreator
+}
+
+// NewCreateCategoryUseCase cria uma nova instância do use case
+func NewCreateCategoryUseCase(repo CategoryCreator) *CreateCategoryUseCase {
+	return &CreateCategoryUseCase{
+		repo: repo,
+	}
+}
+
+// CreateCategoryInput representa os dados de entrada para criar uma categoria
+type CreateCategoryInput struct {
+	Actor    domain.Actor
+	Name     string
+	Slug     string // Opcional, será gerado a partir do nome se vazio
+	Icon     string
+	Position int
+}
+
+// Execute executa o caso de uso de criação de categoria
+func (uc *CreateCategoryUseCase) Execute(ctx context.Context, input CreateCategoryInput) (*domain.Category, error) {
+	if err := domain.CanManageCategories(input.Actor); err != nil {
+		return nil, fmt.Errorf("create category usecase: %w", err)
+	}
+
+	category := &domain.Category{
+		Name:     input.Name,
+		Slug:     input.Slug,
+		Icon:     input.Icon,
+		Position: input.Position,
+	}
+	if category.Slug == "" {
+		category.Slug = utils.GenerateSlug(input.Name)
+	}
+	if err := category.Validate(); err != nil {
+		return nil, fmt.Errorf("create category usecase: %w", err)
+	}
+
+	exists, err := uc.repo.CategorySlugExists(ctx, category.Slug, uuid.Nil)
+	if err != nil {
+		return nil, fmt.Errorf("create category usecase: check slug uniqueness: %w", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("create category usecase: %w", domain.ErrCategorySlugAlreadyExists)
+	}
+
+	if err := uc.repo.CreateCategory(ctx, category); err != nil {
+		return nil, fmt.Errorf("create category usecase: %w", err)
+	}
+
+	return category, nil
+}
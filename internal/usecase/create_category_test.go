@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockCategoryCreator é um mock específico para CategoryCreator
+type MockCategoryCreator struct {
+	mock.Mock
+}
+
+func (m *MockCategoryCreator) CategorySlugExists(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, slug, excludeID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockCategoryCreator) CreateCategory(ctx context.Context, category *domain.Category) error {
+	args := m.Called(ctx, category)
+	return args.Error(0)
+}
+
+func TestCreateCategoryUseCase_Execute_Success(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := CreateCategoryInput{
+		Actor:    domain.Actor{ID: "ops", Role: domain.RoleAdmin},
+		Name:     "Comida Japonesa",
+		Position: 3,
+	}
+
+	// Mock
+	mockRepo := new(MockCategoryCreator)
+	mockRepo.On("CategorySlugExists", ctx, "comida-japonesa", uuid.Nil).Return(false, nil)
+	mockRepo.On("CreateCategory", ctx, mock.AnythingOfType("*domain.Category")).Return(nil)
+
+	// Execute
+	uc := NewCreateCategoryUseCase(mockRepo)
+	category, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "comida-japonesa", category.Slug)
+	assert.Equal(t, "Comida Japonesa", category.Name)
+	assert.Equal(t, 3, category.Position)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateCategoryUseCase_Execute_RequiresAdmin(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := CreateCategoryInput{
+		Actor: domain.Actor{ID: "owner", Role: domain.RoleMerchant},
+		Name:  "Pizza",
+	}
+
+	// Mock
+	mockRepo := new(MockCategoryCreator)
+
+	// Execute
+	uc := NewCreateCategoryUseCase(mockRepo)
+	category, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, category)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	mockRepo.AssertNotCalled(t, "CreateCategory", mock.Anything, mock.Anything)
+}
+
+func TestCreateCategoryUseCase_Execute_SlugConflict(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := CreateCategoryInput{
+		Actor: domain.Actor{ID: "ops", Role: domain.RoleAdmin},
+		Name:  "Pizza",
+	}
+
+	// Mock
+	mockRepo := new(MockCategoryCreator)
+	mockRepo.On("CategorySlugExists", ctx, "pizza", uuid.Nil).Return(true, nil)
+
+	// Execute
+	uc := NewCreateCategoryUseCase(mockRepo)
+	category, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, category)
+	assert.ErrorIs(t, err, domain.ErrCategorySlugAlreadyExists)
+	assert.ErrorIs(t, err, domain.ErrConflict)
+	mockRepo.AssertNotCalled(t, "CreateCategory", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"gastro-go/internal/domain"
+)
+
+// MenuItemCreator define a interface mínima necessária para criar itens do cardápio
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
+type MenuItemCreator interface {
+	GetMenuSection(ctx context.Context, restaurantID, id uuid.UUID) (*domain.MenuSection, error)
+	CreateMenuItem(ctx context.Context, item *domain.MenuItem) error
+}
+
+// CreateMenuItemUseCase implementa o caso de uso de criação de item do cardápio
+type CreateMenuItemUseCase struct {
+	repo MenuItemCreator
+}
+
+// NewCreateMenuItemUseCase cria uma nova instância do use case
+func NewCreateMenuItemUseCase(repo MenuItemCreator) *CreateMenuItemUseCase {
+	return &CreateMenuItemUseCase{
+		repo: repo,
+	}
+}
+
+// MenuItemInput representa os dados editáveis de um item do cardápio
+type MenuItemInput struct {
+	Name        string
+	Description string
+	Price       int64 // Em centavos
+	ImageURL    string
+	Position    int
+	Active      bool
+}
+
+// CreateMenuItemInput representa os dados de entrada para criar um item
+type CreateMenuItemInput struct {
+	RestaurantID uuid.UUID
+	SectionID    uuid.UUID
+	Item         MenuItemInput
+}
+
+// Execute executa o caso de uso de criação de item
+// A seção precisa pertencer ao restaurante informado
+func (uc *CreateMenuItemUseCase) Execute(ctx context.Context, input CreateMenuItemInput) (*domain.MenuItem, error) {
+	item := input.Item.toDomain(input.RestaurantID, input.SectionID)
+	if err := item.Validate(); err != nil {
+		return nil, fmt.Errorf("create menu item usecase: %w", err)
+	}
+
+	if _, err := uc.repo.GetMenuSection(ctx, input.RestaurantID, input.SectionID); err != nil {
+		return nil, fmt.Errorf("create menu item usecase: %w", err)
+	}
+
+	if err := uc.repo.CreateMenuItem(ctx, item); err != nil {
+		return nil, fmt.Errorf("create menu item usecase: %w", err)
+	}
+
+	return item, nil
+}
+
+// toDomain converte o input em item da seção
+func (in MenuItemInput) toDomain(restaurantID, sectionID uuid.UUID) *domain.MenuItem {
+	return &domain.MenuItem{
+		RestaurantID: restaurantID,
+		SectionID:    sectionID,
+		Name:         in.Name,
+		Description:  in.Description,
+		Price:        in.Price,
+		ImageURL:     in.ImageURL,
+		Position:     in.Position,
+		Active:       in.Active,
+	}
+}
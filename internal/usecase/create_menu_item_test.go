@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockMenuItemCreator é um mock específico para MenuItemCreator
+type MockMenuItemCreator struct {
+	mock.Mock
+}
+
+func (m *MockMenuItemCreator) GetMenuSection(ctx context.Context, restaurantID, id uuid.UUID) (*domain.MenuSection, error) {
+	args := m.Called(ctx, restaurantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.MenuSection), args.Error(1)
+}
+
+func (m *MockMenuItemCreator) CreateMenuItem(ctx context.Context, item *domain.MenuItem) error {
+	args := m.Called(ctx, item)
+	return args.Error(0)
+}
+
+func TestCreateMenuItemUseCase_Execute_Success(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	sectionID := uuid.New()
+	input := CreateMenuItemInput{
+		RestaurantID: restaurantID,
+		SectionID:    sectionID,
+		Item: MenuItemInput{
+			Name:     " Margherita ",
+			Price:    4590,
+			Position: 1,
+			Active:   true,
+		},
+	}
+
+	// Mock
+	mockRepo := new(MockMenuItemCreator)
+	mockRepo.On("GetMenuSection", ctx, restaurantID, sectionID).Return(&domain.MenuSection{ID: sectionID, RestaurantID: restaurantID}, nil)
+	mockRepo.On("CreateMenuItem", ctx, mock.AnythingOfType("*domain.MenuItem")).Return(nil)
+
+	// Execute
+	uc := NewCreateMenuItemUseCase(mockRepo)
+	item, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Margherita", item.Name)
+	assert.Equal(t, int64(4590), item.Price)
+	assert.Equal(t, sectionID, item.SectionID)
+	assert.Equal(t, restaurantID, item.RestaurantID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateMenuItemUseCase_Execute_NegativePrice(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := CreateMenuItemInput{
+		RestaurantID: uuid.New(),
+		SectionID:    uuid.New(),
+		Item:         MenuItemInput{Name: "Margherita", Price: -100},
+	}
+
+	// Mock
+	mockRepo := new(MockMenuItemCreator)
+
+	// Execute
+	uc := NewCreateMenuItemUseCase(mockRepo)
+	item, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, item)
+	var domainErr *domain.Error
+	assert.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "invalid_price", domainErr.Code)
+	mockRepo.AssertNotCalled(t, "CreateMenuItem", mock.Anything, mock.Anything)
+}
+
+func TestCreateMenuItemUseCase_Execute_SectionFromAnotherRestaurant(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	sectionID := uuid.New()
+	input := CreateMenuItemInput{
+		RestaurantID: restaurantID,
+		SectionID:    sectionID,
+		Item:         MenuItemInput{Name: "Margherita", Price: 4590},
+	}
+
+	// Mock
+	mockRepo := new(MockMenuItemCreator)
+	mockRepo.On("GetMenuSection", ctx, restaurantID, sectionID).Return(nil, domain.ErrMenuSectionNotFound)
+
+	// Execute
+	uc := NewCreateMenuItemUseCase(mockRepo)
+	item, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, item)
+	assert.ErrorIs(t, err, domain.ErrMenuSectionNotFound)
+	mockRepo.AssertNotCalled(t, "CreateMenuItem", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"gastro-go/internal/domain"
+)
+
+// MenuSectionCreator define a interface mínima necessária para criar seções do cardápio
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
+type MenuSectionCreator interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error)
+	CreateMenuSection(ctx context.Context, section *domain.MenuSection) error
+}
+
+// CreateMenuSectionUseCase implementa o caso de uso de criação de seção do cardápio
+type CreateMenuSectionUseCase struct {
+	repo MenuSectionCreator
+}
+
+// NewCreateMenuSectionUseCase cria uma nova instância do use case
+func NewCreateMenuSectionUseCase(repo MenuSectionCreator) *CreateMenuSectionUseCase {
+	return &CreateMenuSectionUseCase{
+		repo: repo,
+	}
+}
+
+// MenuSectionInput representa os dados editáveis de uma seção do cardápio
+type MenuSectionInput struct {
+	Name        string
+	Description string
+	Position    int
+	Active      bool
+}
+
+// CreateMenuSectionInput representa os dados de entrada para criar uma seção
+type CreateMenuSectionInput struct {
+	RestaurantID uuid.UUID
+	Section      MenuSectionInput
+}
+
+// Execute executa o caso de uso de criação de seção
+func (uc *CreateMenuSectionUseCase) Execute(ctx context.Context, input CreateMenuSectionInput) (*domain.MenuSection, error) {
+	if _, err := uc.repo.GetByID(ctx, input.RestaurantID); err != nil {
+		return nil, fmt.Errorf("create menu section usecase: %w", err)
+	}
+
+	section := input.Section.toDomain(input.RestaurantID)
+	if err := section.Validate(); err != nil {
+		return nil, fmt.Errorf("create menu section usecase: %w", err)
+	}
+
+	if err := uc.repo.CreateMenuSection(ctx, section); err != nil {
+		return nil, fmt.Errorf("create menu section usecase: %w", err)
+	}
+
+	return section, nil
+}
+
+// toDomain converte o input em seção do restaurante
+func (in MenuSectionInput) toDomain(restaurantID uuid.UUID) *domain.MenuSection {
+	return &domain.MenuSection{
+		RestaurantID: restaurantID,
+		Name:         in.Name,
+		Description:  in.Description,
+		Position:     in.Position,
+		Active:       in.Active,
+	}
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockMenuSectionCreator é um mock específico para MenuSectionCreator
+type MockMenuSectionCreator struct {
+	mock.Mock
+}
+
+func (m *MockMenuSectionCreator) GetByID(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Restaurant), args.Error(1)
+}
+
+func (m *MockMenuSectionCreator) CreateMenuSection(ctx context.Context, section *domain.MenuSection) error {
+	args := m.Called(ctx, section)
+	return args.Error(0)
+}
+
+func TestCreateMenuSectionUseCase_Execute_Success(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := CreateMenuSectionInput{
+		RestaurantID: restaurantID,
+		Section:      MenuSectionInput{Name: " Pizzas ", Description: "Forno a lenha", Position: 1, Active: true},
+	}
+
+	// Mock
+	mockRepo := new(MockMenuSectionCreator)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID}, nil)
+	mockRepo.On("CreateMenuSection", ctx, mock.AnythingOfType("*domain.MenuSection")).Return(nil)
+
+	// Execute
+	uc := NewCreateMenuSectionUseCase(mockRepo)
+	section, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Pizzas", section.Name)
+	assert.Equal(t, restaurantID, section.RestaurantID)
+	assert.Equal(t, 1, section.Position)
+	assert.True(t, section.Active)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateMenuSectionUseCase_Execute_RestaurantNotFound(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := CreateMenuSectionInput{RestaurantID: uuid.New(), Section: MenuSectionInput{Name: "Pizzas"}}
+
+	// Mock
+	mockRepo := new(MockMenuSectionCreator)
+	mockRepo.On("GetByID", ctx, input.RestaurantID).Return(nil, domain.ErrRestaurantNotFound)
+
+	// Execute
+	uc := NewCreateMenuSectionUseCase(mockRepo)
+	section, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, section)
+	assert.ErrorIs(t, err, domain.ErrRestaurantNotFound)
+	mockRepo.AssertNotCalled(t, "CreateMenuSection", mock.Anything, mock.Anything)
+}
+
+func TestCreateMenuSectionUseCase_Execute_NameRequired(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := CreateMenuSectionInput{RestaurantID: restaurantID, Section: MenuSectionInput{Name: "   "}}
+
+	// Mock
+	mockRepo := new(MockMenuSectionCreator)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID}, nil)
+
+	// Execute
+	uc := NewCreateMenuSectionUseCase(mockRepo)
+	section, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, section)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	mockRepo.AssertNotCalled(t, "CreateMenuSection", mock.Anything, mock.Anything)
+}
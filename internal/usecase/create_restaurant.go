@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 
@@ -14,19 +15,26 @@ import (
 // RestaurantCreator define a interface mínima necessária para criar restaurantes
 // Segue Interface Segregation Principle: apenas os métodos que este use case precisa
 type RestaurantCreator interface {
-	SlugExists(ctx context.Context, slug string) (bool, error)
+	SlugExists(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error)
+	ListTakenSlugs(ctx context.Context, base string) ([]string, error)
 	Create(ctx context.Context, restaurant *domain.Restaurant) error
+	CategoryResolver
 }
 
+// maxSlugAttempts limita as tentativas de slug gerado sob inserções concorrentes
+const maxSlugAttempts = 3
+
 // CreateRestaurantUseCase implementa o caso de uso de criação de restaurante
 type CreateRestaurantUseCase struct {
 	repo RestaurantCreator
+	tx   TxRunner
 }
 
 // NewCreateRestaurantUseCase cria uma nova instância do use case
-func NewCreateRestaurantUseCase(repo RestaurantCreator) *CreateRestaurantUseCase {
+func NewCreateRestaurantUseCase(repo RestaurantCreator, tx TxRunner) *CreateRestaurantUseCase {
 	return &CreateRestaurantUseCase{
 		repo: repo,
+		tx:   tx,
 	}
 }
 
@@ -35,7 +43,7 @@ type CreateRestaurantInput struct {
 	Name               string
 	Slug               string // Opcional, será gerado se vazio
 	Description        string
-	Category           string
+	Categories         []string // IDs ou slugs de categorias cadastradas; a primeira é a principal
 	DeliveryFee        int64
 	MinOrderValue      int64
 	PreparationTimeMin int
@@ -43,6 +51,7 @@ type CreateRestaurantInput struct {
 	SupportsDelivery   bool
 	LogoURL            string
 	BannerURL          string
+	Timezone           string // Opcional, fuso IANA; vazio = inferido pela UF do endereço
 	Address            *CreateAddressInput
 }
 
@@ -54,48 +63,36 @@ type CreateAddressInput struct {
 	City       string
 	State      string
 	ZipCode    string
-	Lat        float64
-	Lng        float64
+	Lat        *float64 // Opcional no DRAFT
+	Lng        *float64 // Opcional no DRAFT
 }
 
-// Execute executa o caso de uso de criação de restaurante
-func (uc *CreateRestaurantUseCase) Execute(ctx context.Context, input CreateRestaurantInput) (*domain.Restaurant, error) {
-	// Validações
-	if input.Name == "" {
-		return nil, fmt.Errorf("create restaurant usecase: name is required")
-	}
-
-	if input.DeliveryFee < 0 {
-		return nil, fmt.Errorf("create restaurant usecase: delivery fee cannot be negative")
-	}
-
-	if input.MinOrderValue < 0 {
-		return nil, fmt.Errorf("create restaurant usecase: min order value cannot be negative")
-	}
-
-	// Gerar slug se não fornecido
-	slug := input.Slug
-	if slug == "" {
-		slug = utils.GenerateSlug(input.Name)
-	}
-
-	// Verificar se o slug já existe
-	exists, err := uc.repo.SlugExists(ctx, slug)
-	if err != nil {
-		return nil, fmt.Errorf("create restaurant usecase: check slug uniqueness: %w", err)
-	}
-	if exists {
-		return nil, fmt.Errorf("create restaurant usecase: slug already exists: %w", errors.New("conflict"))
+// toDomain converte o input em endereço normalizado do restaurante
+func (in CreateAddressInput) toDomain(restaurantID uuid.UUID) *domain.Address {
+	address := &domain.Address{
+		ID:           uuid.New(),
+		RestaurantID: restaurantID,
+		Street:       in.Street,
+		Number:       in.Number,
+		Complement:   in.Complement,
+		City:         in.City,
+		State:        in.State,
+		ZipCode:      in.ZipCode,
+		Lat:          in.Lat,
+		Lng:          in.Lng,
 	}
+	address.Normalize()
+	return address
+}
 
+// Execute executa o caso de uso de criação de restaurante
+func (uc *CreateRestaurantUseCase) Execute(ctx context.Context, input CreateRestaurantInput) (*domain.Restaurant, error) {
 	// Criar restaurante
 	restaurant := &domain.Restaurant{
 		ID:                 uuid.New(),
 		Name:               input.Name,
-		Slug:               slug,
 		Description:        input.Description,
 		Status:             domain.StatusDraft,
-		Category:           input.Category,
 		Rating:             0,
 		TotalReviews:       0,
 		DeliveryFee:        input.DeliveryFee,
@@ -105,29 +102,144 @@ func (uc *CreateRestaurantUseCase) Execute(ctx context.Context, input CreateRest
 		SupportsDelivery:   input.SupportsDelivery,
 		LogoURL:            input.LogoURL,
 		BannerURL:          input.BannerURL,
+		Timezone:           input.Timezone,
+	}
+
+	// Validações
+	if err := restaurant.ValidateProfile(); err != nil {
+		return nil, fmt.Errorf("create restaurant usecase: %w", err)
+	}
+
+	// Resolver categorias informadas por ID ou slug
+	categories, err := resolveCategories(ctx, uc.repo, input.Categories)
+	if err != nil {
+		return nil, fmt.Errorf("create restaurant usecase: %w", err)
+	}
+	if err := restaurant.SetCategories(categories); err != nil {
+		return nil, fmt.Errorf("create restaurant usecase: %w", err)
 	}
 
-	// Criar endereço se fornecido
+	// Criar endereço se fornecido (coordenadas opcionais no DRAFT)
 	if input.Address != nil {
-		restaurant.Address = &domain.Address{
-			ID:           uuid.New(),
-			RestaurantID: restaurant.ID,
-			Street:       input.Address.Street,
-			Number:       input.Address.Number,
-			Complement:   input.Address.Complement,
-			City:         input.Address.City,
-			State:        input.Address.State,
-			ZipCode:      input.Address.ZipCode,
-			Lat:          input.Address.Lat,
-			Lng:          input.Address.Lng,
+		restaurant.Address = input.Address.toDomain(restaurant.ID)
+		if err := restaurant.Address.Validate(false); err != nil {
+			return nil, fmt.Errorf("create restaurant usecase: %w", err)
 		}
 	}
 
-	// Salvar no banco
-	if err := uc.repo.Create(ctx, restaurant); err != nil {
+	// Slug informado é validado e usado como está; o gerado ganha sufixo (-2, -3, ...) até ficar livre
+	if input.Slug != "" {
+		err = uc.createWithSlug(ctx, restaurant, input.Slug)
+	} else {
+		err = uc.createWithGeneratedSlug(ctx, restaurant)
+	}
+	if err != nil {
 		return nil, fmt.Errorf("create restaurant usecase: %w", err)
 	}
 
 	return restaurant, nil
 }
 
+// createWithSlug cria o restaurante com o slug escolhido pelo cliente
+func (uc *CreateRestaurantUseCase) createWithSlug(ctx context.Context, restaurant *domain.Restaurant, slug string) error {
+	if err := domain.ValidateSlug(slug); err != nil {
+		return err
+	}
+
+	exists, err := uc.repo.SlugExists(ctx, slug, uuid.Nil)
+	if err != nil {
+		return fmt.Errorf("check slug uniqueness: %w", err)
+	}
+	if exists {
+		return domain.ErrSlugAlreadyExists
+	}
+
+	restaurant.Slug = slug
+	return uc.insert(ctx, restaurant)
+}
+
+// createWithGeneratedSlug cria o restaurante com o primeiro slug livre derivado do nome
+// Uma inserção concorrente com o mesmo slug (violação do índice único) leva a nova tentativa
+func (uc *CreateRestaurantUseCase) createWithGeneratedSlug(ctx context.Context, restaurant *domain.Restaurant) error {
+	base := domain.SlugBase(utils.GenerateSlug(restaurant.Name))
+	if base == "" {
+		return domain.NewValidationError("invalid_slug", "could not generate a slug from the name; provide one")
+	}
+
+	for attempt := 0; attempt < maxSlugAttempts; attempt++ {
+		taken, err := uc.repo.ListTakenSlugs(ctx, base)
+		if err != nil {
+			return fmt.Errorf("list taken slugs: %w", err)
+		}
+		restaurant.Slug = domain.NextFreeSlug(base, taken)
+
+		err = uc.insert(ctx, restaurant)
+		if !errors.Is(err, domain.ErrSlugAlreadyExists) {
+			return err
+		}
+	}
+	return domain.ErrSlugAlreadyExists
+}
+
+// insert salva restaurante, endereço e categorias na mesma transação
+func (uc *CreateRestaurantUseCase) insert(ctx context.Context, restaurant *domain.Restaurant) error {
+	return uc.tx.RunInTx(ctx, func(ctx context.Context) error {
+		return uc.repo.Create(ctx, restaurant)
+	})
+}
+
+// CategoryResolver define a interface mínima para resolver categorias informadas por ID ou slug
+type CategoryResolver interface {
+	ResolveCategories(ctx context.Context, ids []uuid.UUID, slugs []string) ([]domain.Category, error)
+}
+
+// resolveCategories busca as categorias referenciadas, preservando a ordem e ignorando repetições
+// Uma referência que não corresponde a nenhuma categoria é um erro de validação
+func resolveCategories(ctx context.Context, repo CategoryResolver, refs []string) ([]domain.Category, error) {
+	if len(refs) == 0 {
+		return nil, nil
+	}
+	if len(refs) > domain.MaxRestaurantCategories {
+		return nil, domain.NewValidationError("too_many_categories", fmt.Sprintf("a restaurant can have at most %d categories", domain.MaxRestaurantCategories))
+	}
+
+	// Referências normalizadas: UUID na forma canônica, slug em minúsculas
+	keys := make([]string, 0, len(refs))
+	var ids []uuid.UUID
+	var slugs []string
+	for _, ref := range refs {
+		if id, err := uuid.Parse(ref); err == nil {
+			ids = append(ids, id)
+			keys = append(keys, id.String())
+			continue
+		}
+		slug := strings.ToLower(strings.TrimSpace(ref))
+		slugs = append(slugs, slug)
+		keys = append(keys, slug)
+	}
+
+	found, err := repo.ResolveCategories(ctx, ids, slugs)
+	if err != nil {
+		return nil, err
+	}
+	byRef := make(map[string]domain.Category, len(found)*2)
+	for _, category := range found {
+		byRef[category.ID.String()] = category
+		byRef[category.Slug] = category
+	}
+
+	categories := make([]domain.Category, 0, len(refs))
+	seen := make(map[uuid.UUID]bool, len(refs))
+	for i, key := range keys {
+		category, ok := byRef[key]
+		if !ok {
+			return nil, domain.NewValidationError("unknown_category", fmt.Sprintf("unknown category: %s", refs[i]))
+		}
+		if !seen[category.ID] {
+			seen[category.ID] = true
+			categories = append(categories, category)
+		}
+	}
+	return categories, nil
+}
+
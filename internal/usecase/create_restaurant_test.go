@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
@@ -17,23 +18,39 @@ type MockRestaurantCreator struct {
 	mock.Mock
 }
 
-func (m *MockRestaurantCreator) SlugExists(ctx context.Context, slug string) (bool, error) {
-	args := m.Called(ctx, slug)
+func (m *MockRestaurantCreator) SlugExists(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, slug, excludeID)
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockRestaurantCreator) ListTakenSlugs(ctx context.Context, base string) ([]string, error) {
+	args := m.Called(ctx, base)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
 func (m *MockRestaurantCreator) Create(ctx context.Context, restaurant *domain.Restaurant) error {
 	args := m.Called(ctx, restaurant)
 	return args.Error(0)
 }
 
+func (m *MockRestaurantCreator) ResolveCategories(ctx context.Context, ids []uuid.UUID, slugs []string) ([]domain.Category, error) {
+	args := m.Called(ctx, ids, slugs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Category), args.Error(1)
+}
+
 func TestCreateRestaurantUseCase_Execute_Success(t *testing.T) {
 	// Input
 	ctx := context.Background()
 	input := CreateRestaurantInput{
 		Name:               "Pizza do João",
 		Description:        "Melhor pizza da cidade",
-		Category:           "Pizza",
+		Categories:         []string{"Pizza"},
 		DeliveryFee:        500,  // R$ 5,00
 		MinOrderValue:      2000, // R$ 20,00
 		PreparationTimeMin: 30,
@@ -42,12 +59,14 @@ func TestCreateRestaurantUseCase_Execute_Success(t *testing.T) {
 	}
 
 	// Mock
+	pizza := domain.Category{ID: uuid.New(), Slug: "pizza", Name: "Pizza"}
 	mockRepo := new(MockRestaurantCreator)
-	mockRepo.On("SlugExists", ctx, mock.AnythingOfType("string")).Return(false, nil)
+	mockRepo.On("ResolveCategories", ctx, []uuid.UUID(nil), []string{"pizza"}).Return([]domain.Category{pizza}, nil)
+	mockRepo.On("ListTakenSlugs", ctx, "pizza-do-joao").Return([]string(nil), nil)
 	mockRepo.On("Create", ctx, mock.AnythingOfType("*domain.Restaurant")).Return(nil)
 
 	// Execute
-	uc := NewCreateRestaurantUseCase(mockRepo)
+	uc := NewCreateRestaurantUseCase(mockRepo, fakeTxRunner{})
 	restaurant, err := uc.Execute(ctx, input)
 
 	// Assert
@@ -57,7 +76,9 @@ func TestCreateRestaurantUseCase_Execute_Success(t *testing.T) {
 	assert.Equal(t, domain.StatusDraft, restaurant.Status)
 	assert.Equal(t, int64(500), restaurant.DeliveryFee)
 	assert.Equal(t, int64(2000), restaurant.MinOrderValue)
-	assert.NotEmpty(t, restaurant.Slug)
+	assert.Equal(t, "pizza-do-joao", restaurant.Slug)
+	assert.Equal(t, "Pizza", restaurant.Category)
+	assert.Equal(t, []domain.Category{pizza}, restaurant.Categories)
 	mockRepo.AssertExpectations(t)
 }
 
@@ -66,20 +87,22 @@ func TestCreateRestaurantUseCase_Execute_SlugConflict(t *testing.T) {
 	ctx := context.Background()
 	input := CreateRestaurantInput{
 		Name: "Pizza do João",
+		Slug: "pizza-do-joao",
 	}
 
 	// Mock
 	mockRepo := new(MockRestaurantCreator)
-	mockRepo.On("SlugExists", ctx, mock.AnythingOfType("string")).Return(true, nil)
+	mockRepo.On("SlugExists", ctx, "pizza-do-joao", uuid.Nil).Return(true, nil)
 
 	// Execute
-	uc := NewCreateRestaurantUseCase(mockRepo)
+	uc := NewCreateRestaurantUseCase(mockRepo, fakeTxRunner{})
 	restaurant, err := uc.Execute(ctx, input)
 
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, restaurant)
 	assert.Contains(t, err.Error(), "slug already exists")
+	assert.ErrorIs(t, err, domain.ErrConflict)
 	mockRepo.AssertExpectations(t)
 }
 
@@ -96,13 +119,102 @@ func TestCreateRestaurantUseCase_Execute_ValidationError(t *testing.T) {
 	mockRepo := new(MockRestaurantCreator)
 
 	// Execute
-	uc := NewCreateRestaurantUseCase(mockRepo)
+	uc := NewCreateRestaurantUseCase(mockRepo, fakeTxRunner{})
 	restaurant, err := uc.Execute(ctx, input)
 
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, restaurant)
 	assert.Contains(t, err.Error(), "name is required")
+	assert.ErrorIs(t, err, domain.ErrValidation)
 	mockRepo.AssertNotCalled(t, "Create")
 }
 
+
+func TestCreateRestaurantUseCase_Execute_UnknownCategory(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	pizzaID := uuid.New()
+	input := CreateRestaurantInput{
+		Name:       "Pizza do João",
+		Categories: []string{pizzaID.String(), "sushi"},
+	}
+
+	// Mock
+	mockRepo := new(MockRestaurantCreator)
+	mockRepo.On("ResolveCategories", ctx, []uuid.UUID{pizzaID}, []string{"sushi"}).
+		Return([]domain.Category{{ID: pizzaID, Slug: "pizza", Name: "Pizza"}}, nil)
+
+	// Execute
+	uc := NewCreateRestaurantUseCase(mockRepo, fakeTxRunner{})
+	restaurant, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, restaurant)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	var domainErr *domain.Error
+	assert.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "unknown_category", domainErr.Code)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCreateRestaurantUseCase_Execute_GeneratedSlugSuffix(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := CreateRestaurantInput{
+		Name: "Pizza do João",
+	}
+
+	// Mock: base e -2 ocupados; -3 perde a corrida para uma inserção concorrente
+	mockRepo := new(MockRestaurantCreator)
+	mockRepo.On("ListTakenSlugs", ctx, "pizza-do-joao").Return([]string{"pizza-do-joao", "pizza-do-joao-2"}, nil).Once()
+	mockRepo.On("ListTakenSlugs", ctx, "pizza-do-joao").Return([]string{"pizza-do-joao", "pizza-do-joao-2", "pizza-do-joao-3"}, nil).Once()
+	mockRepo.On("Create", ctx, mock.MatchedBy(func(r *domain.Restaurant) bool { return r.Slug == "pizza-do-joao-3" })).
+		Return(domain.ErrSlugAlreadyExists).Once()
+	mockRepo.On("Create", ctx, mock.MatchedBy(func(r *domain.Restaurant) bool { return r.Slug == "pizza-do-joao-4" })).
+		Return(nil).Once()
+
+	// Execute
+	uc := NewCreateRestaurantUseCase(mockRepo, fakeTxRunner{})
+	restaurant, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "pizza-do-joao-4", restaurant.Slug)
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "SlugExists", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateRestaurantUseCase_Execute_InvalidSlug(t *testing.T) {
+	tests := []struct {
+		name string
+		slug string
+		code string
+	}{
+		{name: "uppercase", slug: "Pizza-Do-Joao", code: "invalid_slug"},
+		{name: "double hyphen", slug: "pizza--joao", code: "invalid_slug"},
+		{name: "reserved", slug: "facets", code: "reserved_slug"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Input
+			ctx := context.Background()
+			input := CreateRestaurantInput{Name: "Pizza do João", Slug: tt.slug}
+
+			// Mock
+			mockRepo := new(MockRestaurantCreator)
+
+			// Execute
+			uc := NewCreateRestaurantUseCase(mockRepo, fakeTxRunner{})
+			restaurant, err := uc.Execute(ctx, input)
+
+			// Assert
+			assert.Nil(t, restaurant)
+			var domainErr *domain.Error
+			assert.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, tt.code, domainErr.Code)
+			mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"gastro-go/internal/domain"
+)
+
+// CategoryDeleter define a interface mínima necessária para remover categorias
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
+type CategoryDeleter interface {
+	DeleteCategory(ctx context.Context, id uuid.UUID) error
+}
+
+// DeleteCategoryUseCase implementa o caso de uso de remoção de categoria (somente admin)
+type DeleteCategoryUseCase struct {
+	repo CategoryDeleter
+}
+
+// NewDeleteCategoryUseCase cria uma nova instância do use case
+func NewDeleteCategoryUseCase(repo CategoryDeleter) *DeleteCategoryUseCase {
+	return &DeleteCategoryUseCase{
+		repo: repo,
+	}
+}
+
+// DeleteCategoryInput representa os dados de entrada para remover uma categoria
+type DeleteCategoryInput struct {
+	ID    uuid.UUID
+	Actor domain.Actor
+}
+
+// Execute executa o caso de uso de remoção de categoria
+// Categorias com restaurantes não podem ser removidas; o repositório retorna ErrCategoryInUse
+func (uc *DeleteCategoryUseCase) Execute(ctx context.Context, input DeleteCategoryInput) error {
+	if err := domain.CanManageCategories(input.Actor); err != nil {
+		return fmt.Errorf("delete category usecase: %w", err)
+	}
+
+	if err := uc.repo.DeleteCategory(ctx, input.ID); err != nil {
+		return fmt.Errorf("delete category usecase: %w", err)
+	}
+
+	return nil
+}
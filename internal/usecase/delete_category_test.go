@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockCategoryDeleter é um mock específico para CategoryDeleter
+type MockCategoryDeleter struct {
+	mock.Mock
+}
+
+func (m *MockCategoryDeleter) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func TestDeleteCategoryUseCase_Execute_Success(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := DeleteCategoryInput{ID: uuid.New(), Actor: domain.Actor{ID: "ops", Role: domain.RoleAdmin}}
+
+	// Mock
+	mockRepo := new(MockCategoryDeleter)
+	mockRepo.On("DeleteCategory", ctx, input.ID).Return(nil)
+
+	// Execute
+	uc := NewDeleteCategoryUseCase(mockRepo)
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDeleteCategoryUseCase_Execute_RequiresAdmin(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := DeleteCategoryInput{ID: uuid.New(), Actor: domain.Actor{ID: "owner", Role: domain.RoleMerchant}}
+
+	// Mock
+	mockRepo := new(MockCategoryDeleter)
+
+	// Execute
+	uc := NewDeleteCategoryUseCase(mockRepo)
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	mockRepo.AssertNotCalled(t, "DeleteCategory", mock.Anything, mock.Anything)
+}
+
+func TestDeleteCategoryUseCase_Execute_InUse(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := DeleteCategoryInput{ID: uuid.New(), Actor: domain.Actor{ID: "ops", Role: domain.RoleAdmin}}
+
+	// Mock: a FK de restaurant_categories barra a remoção
+	mockRepo := new(MockCategoryDeleter)
+	mockRepo.On("DeleteCategory", ctx, input.ID).Return(domain.ErrCategoryInUse)
+
+	// Execute
+	uc := NewDeleteCategoryUseCase(mockRepo)
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrCategoryInUse)
+	assert.ErrorIs(t, err, domain.ErrConflict)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDeleteCategoryUseCase_Execute_NotFound(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := DeleteCategoryInput{ID: uuid.New(), Actor: domain.Actor{ID: "ops", Role: domain.RoleAdmin}}
+
+	// Mock
+	mockRepo := new(MockCategoryDeleter)
+	mockRepo.On("DeleteCategory", ctx, input.ID).Return(domain.ErrCategoryNotFound)
+
+	// Execute
+	uc := NewDeleteCategoryUseCase(mockRepo)
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrCategoryNotFound)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// MenuItemDeleter define a interface mínima necessária para remover itens do cardápio
+// Segue Interface Segregation Principle: apenas o método que este use case precisa
+type MenuItemDeleter interface {
+	DeleteMenuItem(ctx context.Context, restaurantID, id uuid.UUID) error
+}
+
+// DeleteMenuItemUseCase implementa o caso de uso de remoção de item do cardápio
+type DeleteMenuItemUseCase struct {
+	repo MenuItemDeleter
+}
+
+// NewDeleteMenuItemUseCase cria uma nova instância do use case
+func NewDeleteMenuItemUseCase(repo MenuItemDeleter) *DeleteMenuItemUseCase {
+	return &DeleteMenuItemUseCase{
+		repo: repo,
+	}
+}
+
+// DeleteMenuItemInput representa os dados de entrada para remover um item
+type DeleteMenuItemInput struct {
+	RestaurantID uuid.UUID
+	ItemID       uuid.UUID
+}
+
+// Execute remove o item do cardápio
+func (uc *DeleteMenuItemUseCase) Execute(ctx context.Context, input DeleteMenuItemInput) error {
+	if err := uc.repo.DeleteMenuItem(ctx, input.RestaurantID, input.ItemID); err != nil {
+		return fmt.Errorf("delete menu item usecase: %w", err)
+	}
+	return nil
+}
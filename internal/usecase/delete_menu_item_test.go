@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockMenuItemDeleter é um mock específico para MenuItemDeleter
+type MockMenuItemDeleter struct {
+	mock.Mock
+}
+
+func (m *MockMenuItemDeleter) DeleteMenuItem(ctx context.Context, restaurantID, id uuid.UUID) error {
+	args := m.Called(ctx, restaurantID, id)
+	return args.Error(0)
+}
+
+func TestDeleteMenuItemUseCase_Execute_Success(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := DeleteMenuItemInput{RestaurantID: uuid.New(), ItemID: uuid.New()}
+
+	// Mock
+	mockRepo := new(MockMenuItemDeleter)
+	mockRepo.On("DeleteMenuItem", ctx, input.RestaurantID, input.ItemID).Return(nil)
+
+	// Execute
+	uc := NewDeleteMenuItemUseCase(mockRepo)
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDeleteMenuItemUseCase_Execute_ItemNotFound(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := DeleteMenuItemInput{RestaurantID: uuid.New(), ItemID: uuid.New()}
+
+	// Mock
+	mockRepo := new(MockMenuItemDeleter)
+	mockRepo.On("DeleteMenuItem", ctx, input.RestaurantID, input.ItemID).Return(domain.ErrMenuItemNotFound)
+
+	// Execute
+	uc := NewDeleteMenuItemUseCase(mockRepo)
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrMenuItemNotFound)
+	mockRepo.AssertExpectations(t)
+}
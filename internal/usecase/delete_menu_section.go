@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// MenuSectionDeleter define a interface mínima necessária para remover seções do cardápio
+// Segue Interface Segregation Principle: apenas o método que este use case precisa
+type MenuSectionDeleter interface {
+	DeleteMenuSection(ctx context.Context, restaurantID, id uuid.UUID) error
+}
+
+// DeleteMenuSectionUseCase implementa o caso de uso de remoção de seção do cardápio
+type DeleteMenuSectionUseCase struct {
+	repo MenuSectionDeleter
+}
+
+// NewDeleteMenuSectionUseCase cria uma nova instância do use case
+func NewDeleteMenuSectionUseCase(repo MenuSectionDeleter) *DeleteMenuSectionUseCase {
+	return &DeleteMenuSectionUseCase{
+		repo: repo,
+	}
+}
+
+// DeleteMenuSectionInput representa os dados de entrada para remover uma seção
+type DeleteMenuSectionInput struct {
+	RestaurantID uuid.UUID
+	SectionID    uuid.UUID
+}
+
+// Execute remove a seção junto com os seus itens
+func (uc *DeleteMenuSectionUseCase) Execute(ctx context.Context, input DeleteMenuSectionInput) error {
+	if err := uc.repo.DeleteMenuSection(ctx, input.RestaurantID, input.SectionID); err != nil {
+		return fmt.Errorf("delete menu section usecase: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockMenuSectionDeleter é um mock específico para MenuSectionDeleter
+type MockMenuSectionDeleter struct {
+	mock.Mock
+}
+
+func (m *MockMenuSectionDeleter) DeleteMenuSection(ctx context.Context, restaurantID, id uuid.UUID) error {
+	args := m.Called(ctx, restaurantID, id)
+	return args.Error(0)
+}
+
+func TestDeleteMenuSectionUseCase_Execute_Success(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := DeleteMenuSectionInput{RestaurantID: uuid.New(), SectionID: uuid.New()}
+
+	// Mock
+	mockRepo := new(MockMenuSectionDeleter)
+	mockRepo.On("DeleteMenuSection", ctx, input.RestaurantID, input.SectionID).Return(nil)
+
+	// Execute
+	uc := NewDeleteMenuSectionUseCase(mockRepo)
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDeleteMenuSectionUseCase_Execute_SectionNotFound(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := DeleteMenuSectionInput{RestaurantID: uuid.New(), SectionID: uuid.New()}
+
+	// Mock
+	mockRepo := new(MockMenuSectionDeleter)
+	mockRepo.On("DeleteMenuSection", ctx, input.RestaurantID, input.SectionID).Return(domain.ErrMenuSectionNotFound)
+
+	// Execute
+	uc := NewDeleteMenuSectionUseCase(mockRepo)
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrMenuSectionNotFound)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"gastro-go/internal/domain"
+)
+
+// SpecialHoursDeleter define a interface mínima necessária para remover exceções de horário
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
+type SpecialHoursDeleter interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error)
+	DeleteSpecialHours(ctx context.Context, restaurantID uuid.UUID, date string) (int64, error)
+}
+
+// DeleteSpecialHoursUseCase implementa o caso de uso de remover a exceção de uma data
+type DeleteSpecialHoursUseCase struct {
+	repo SpecialHoursDeleter
+}
+
+// NewDeleteSpecialHoursUseCase cria uma nova instância do use case
+func NewDeleteSpecialHoursUseCase(repo SpecialHoursDeleter) *DeleteSpecialHoursUseCase {
+	return &DeleteSpecialHoursUseCase{
+		repo: repo,
+	}
+}
+
+// DeleteSpecialHoursInput representa os dados de entrada para remover uma exceção
+type DeleteSpecialHoursInput struct {
+	RestaurantID uuid.UUID
+	Date         string // "2006-01-02"
+}
+
+// Execute executa o caso de uso de remover exceção de horário
+// A data volta a seguir os horários semanais
+func (uc *DeleteSpecialHoursUseCase) Execute(ctx context.Context, input DeleteSpecialHoursInput) error {
+	if _, err := domain.ParseDate(input.Date); err != nil {
+		return fmt.Errorf("delete special hours usecase: %w", err)
+	}
+
+	if _, err := uc.repo.GetByID(ctx, input.RestaurantID); err != nil {
+		return fmt.Errorf("delete special hours usecase: %w", err)
+	}
+
+	deleted, err := uc.repo.DeleteSpecialHours(ctx, input.RestaurantID, input.Date)
+	if err != nil {
+		return fmt.Errorf("delete special hours usecase: %w", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("delete special hours usecase: %w", domain.ErrSpecialHoursNotFound)
+	}
+
+	return nil
+}
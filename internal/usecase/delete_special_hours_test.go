@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockSpecialHoursDeleter é um mock específico para SpecialHoursDeleter
+type MockSpecialHoursDeleter struct {
+	mock.Mock
+}
+
+func (m *MockSpecialHoursDeleter) GetByID(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Restaurant), args.Error(1)
+}
+
+func (m *MockSpecialHoursDeleter) DeleteSpecialHours(ctx context.Context, restaurantID uuid.UUID, date string) (int64, error) {
+	args := m.Called(ctx, restaurantID, date)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestDeleteSpecialHoursUseCase_Execute_Success(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := DeleteSpecialHoursInput{RestaurantID: restaurantID, Date: "2024-12-25"}
+
+	// Mock
+	mockRepo := new(MockSpecialHoursDeleter)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID}, nil)
+	mockRepo.On("DeleteSpecialHours", ctx, restaurantID, "2024-12-25").Return(int64(1), nil)
+
+	// Execute
+	uc := NewDeleteSpecialHoursUseCase(mockRepo)
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDeleteSpecialHoursUseCase_Execute_NotFound(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := DeleteSpecialHoursInput{RestaurantID: restaurantID, Date: "2024-12-25"}
+
+	// Mock
+	mockRepo := new(MockSpecialHoursDeleter)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID}, nil)
+	mockRepo.On("DeleteSpecialHours", ctx, restaurantID, "2024-12-25").Return(int64(0), nil)
+
+	// Execute
+	uc := NewDeleteSpecialHoursUseCase(mockRepo)
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrSpecialHoursNotFound)
+}
+
+func TestDeleteSpecialHoursUseCase_Execute_InvalidDate(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := DeleteSpecialHoursInput{RestaurantID: uuid.New(), Date: "25/12/2024"}
+
+	// Mock
+	mockRepo := new(MockSpecialHoursDeleter)
+
+	// Execute
+	uc := NewDeleteSpecialHoursUseCase(mockRepo)
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.NewValidationError("invalid_date", ""))
+	mockRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+}
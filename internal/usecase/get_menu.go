@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"gastro-go/internal/domain"
+)
+
+// MenuGetter define a interface mínima necessária para montar o cardápio público
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
+type MenuGetter interface {
+	GetBySlug(ctx context.Context, slug string) (*domain.Restaurant, error)
+	GetCanonicalSlug(ctx context.Context, slug string) (string, error)
+	ListMenuSections(ctx context.Context, restaurantID uuid.UUID) ([]domain.MenuSection, error)
+	ListMenuItems(ctx context.Context, restaurantID uuid.UUID) ([]domain.MenuItem, error)
+	ListMenuOptionGroups(ctx context.Context, restaurantID uuid.UUID) ([]domain.MenuOptionGroup, error)
+}
+
+// GetMenuUseCase implementa o caso de uso de buscar o cardápio de um restaurante
+type GetMenuUseCase struct {
+	repo MenuGetter
+	now  func() time.Time
+}
+
+// NewGetMenuUseCase cria uma nova instância do use case
+func NewGetMenuUseCase(repo MenuGetter) *GetMenuUseCase {
+	return &GetMenuUseCase{
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+// GetMenuInput representa os dados de entrada para buscar o cardápio
+type GetMenuInput struct {
+	Slug string
+	At   time.Time // Instante em que a disponibilidade é avaliada; zero = agora
+}
+
+// GetMenuOutput representa o resultado da busca do cardápio
+// Para um slug aposentado, Menu é nil e CanonicalSlug aponta para o slug atual
+type GetMenuOutput struct {
+	Menu          *domain.Menu
+	CanonicalSlug string
+}
+
+// Execute retorna a árvore ordenada do cardápio, apenas com seções, itens e opções ativos
+// Seções e itens fora das suas janelas de venda continuam na árvore, com available=false
+// Restaurantes em rascunho ou suspensos não têm cardápio público (not found)
+func (uc *GetMenuUseCase) Execute(ctx context.Context, input GetMenuInput) (*GetMenuOutput, error) {
+	restaurant, err := uc.repo.GetBySlug(ctx, input.Slug)
+	if errors.Is(err, domain.ErrRestaurantNotFound) {
+		// Mesmo redirecionamento de GET /restaurants/{slug} para slugs aposentados
+		canonical, canonicalErr := uc.repo.GetCanonicalSlug(ctx, input.Slug)
+		if canonicalErr != nil {
+			return nil, fmt.Errorf("get menu usecase: %w", canonicalErr)
+		}
+		return &GetMenuOutput{CanonicalSlug: canonical}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get menu usecase: %w", err)
+	}
+	if !restaurant.IsPublished() {
+		return nil, fmt.Errorf("get menu usecase: %w", domain.ErrRestaurantNotFound)
+	}
+
+	sections, err := uc.repo.ListMenuSections(ctx, restaurant.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get menu usecase: %w", err)
+	}
+	items, err := uc.repo.ListMenuItems(ctx, restaurant.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get menu usecase: %w", err)
+	}
+
+	groups, err := uc.repo.ListMenuOptionGroups(ctx, restaurant.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get menu usecase: %w", err)
+	}
+
+	at := input.At
+	if at.IsZero() {
+		at = uc.now()
+	}
+
+	menu := domain.BuildMenu(restaurant.ID, sections, items, groups)
+	menu.RefreshAvailability(restaurant.LocalTime(at))
+	return &GetMenuOutput{Menu: menu}, nil
+}
@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockMenuGetter é um mock específico para MenuGetter
+type MockMenuGetter struct {
+	mock.Mock
+}
+
+func (m *MockMenuGetter) GetBySlug(ctx context.Context, slug string) (*domain.Restaurant, error) {
+	args := m.Called(ctx, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Restaurant), args.Error(1)
+}
+
+func (m *MockMenuGetter) GetCanonicalSlug(ctx context.Context, slug string) (string, error) {
+	args := m.Called(ctx, slug)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockMenuGetter) ListMenuSections(ctx context.Context, restaurantID uuid.UUID) ([]domain.MenuSection, error) {
+	args := m.Called(ctx, restaurantID)
+	return args.Get(0).([]domain.MenuSection), args.Error(1)
+}
+
+func (m *MockMenuGetter) ListMenuItems(ctx context.Context, restaurantID uuid.UUID) ([]domain.MenuItem, error) {
+	args := m.Called(ctx, restaurantID)
+	return args.Get(0).([]domain.MenuItem), args.Error(1)
+}
+
+func (m *MockMenuGetter) ListMenuOptionGroups(ctx context.Context, restaurantID uuid.UUID) ([]domain.MenuOptionGroup, error) {
+	args := m.Called(ctx, restaurantID)
+	return args.Get(0).([]domain.MenuOptionGroup), args.Error(1)
+}
+
+func TestGetMenuUseCase_Execute_Success(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurant := &domain.Restaurant{ID: uuid.New(), Slug: "pizzaria-napoli", Status: domain.StatusOpen}
+	section := domain.MenuSection{ID: uuid.New(), RestaurantID: restaurant.ID, Name: "Pizzas", Active: true}
+	item := domain.MenuItem{ID: uuid.New(), RestaurantID: restaurant.ID, SectionID: section.ID, Name: "Margherita", Price: 4590, Active: true}
+
+	// Mock
+	mockRepo := new(MockMenuGetter)
+	mockRepo.On("GetBySlug", ctx, "pizzaria-napoli").Return(restaurant, nil)
+	mockRepo.On("ListMenuSections", ctx, restaurant.ID).Return([]domain.MenuSection{section}, nil)
+	mockRepo.On("ListMenuItems", ctx, restaurant.ID).Return([]domain.MenuItem{item}, nil)
+	mockRepo.On("ListMenuOptionGroups", ctx, restaurant.ID).Return([]domain.MenuOptionGroup{}, nil)
+
+	// Execute
+	uc := NewGetMenuUseCase(mockRepo)
+	output, err := uc.Execute(ctx, GetMenuInput{Slug: "pizzaria-napoli"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, output.CanonicalSlug)
+	menu := output.Menu
+	assert.Equal(t, restaurant.ID, menu.RestaurantID)
+	assert.Len(t, menu.Sections, 1)
+	assert.Len(t, menu.Sections[0].Items, 1)
+	assert.Equal(t, item.ID, menu.Sections[0].Items[0].ID)
+	assert.Empty(t, menu.Sections[0].Items[0].OptionGroups)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetMenuUseCase_Execute_RestaurantNotFound(t *testing.T) {
+	// Input
+	ctx := context.Background()
+
+	// Mock
+	mockRepo := new(MockMenuGetter)
+	mockRepo.On("GetBySlug", ctx, "inexistente").Return(nil, domain.ErrRestaurantNotFound)
+	mockRepo.On("GetCanonicalSlug", ctx, "inexistente").Return("", domain.ErrRestaurantNotFound)
+
+	// Execute
+	uc := NewGetMenuUseCase(mockRepo)
+	output, err := uc.Execute(ctx, GetMenuInput{Slug: "inexistente"})
+
+	// Assert
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, domain.ErrRestaurantNotFound)
+	mockRepo.AssertNotCalled(t, "ListMenuSections", mock.Anything, mock.Anything)
+}
+
+func TestGetMenuUseCase_Execute_AvailabilityInRestaurantTimezone(t *testing.T) {
+	// Input: café da manhã de segunda a sexta, 06:00–10:30 em São Paulo
+	ctx := context.Background()
+	restaurant := &domain.Restaurant{ID: uuid.New(), Slug: "padaria-central", Status: domain.StatusClosed, Timezone: "America/Sao_Paulo"}
+	breakfast := domain.MenuSection{ID: uuid.New(), RestaurantID: restaurant.ID, Name: "Café da manhã", Active: true}
+	for weekday := 1; weekday <= 5; weekday++ {
+		breakfast.Availability = append(breakfast.Availability, domain.OpeningHour{Weekday: weekday, OpensAt: 360, ClosesAt: 630})
+	}
+	item := domain.MenuItem{ID: uuid.New(), RestaurantID: restaurant.ID, SectionID: breakfast.ID, Name: "Pão na chapa", Price: 800, Active: true}
+
+	// Mock
+	mockRepo := new(MockMenuGetter)
+	mockRepo.On("GetBySlug", ctx, "padaria-central").Return(restaurant, nil)
+	mockRepo.On("ListMenuSections", ctx, restaurant.ID).Return([]domain.MenuSection{breakfast}, nil)
+	mockRepo.On("ListMenuItems", ctx, restaurant.ID).Return([]domain.MenuItem{item}, nil)
+	mockRepo.On("ListMenuOptionGroups", ctx, restaurant.ID).Return([]domain.MenuOptionGroup{}, nil)
+
+	// Execute: segunda 2025-06-02 às 12:00 UTC = 09:00 em São Paulo; 14:00 UTC = 11:00
+	uc := NewGetMenuUseCase(mockRepo)
+	morning, morningErr := uc.Execute(ctx, GetMenuInput{Slug: "padaria-central", At: time.Date(2025, 6, 2, 12, 0, 0, 0, time.UTC)})
+	noon, noonErr := uc.Execute(ctx, GetMenuInput{Slug: "padaria-central", At: time.Date(2025, 6, 2, 14, 0, 0, 0, time.UTC)})
+
+	// Assert
+	assert.NoError(t, morningErr)
+	assert.True(t, morning.Menu.Sections[0].Available)
+	assert.True(t, morning.Menu.Sections[0].Items[0].Available)
+	assert.Equal(t, 9, morning.Menu.EvaluatedAt.Hour())
+	assert.NoError(t, noonErr)
+	assert.False(t, noon.Menu.Sections[0].Available)
+	assert.False(t, noon.Menu.Sections[0].Items[0].Available)
+}
+
+func TestGetMenuUseCase_Execute_RetiredSlug(t *testing.T) {
+	// Input
+	ctx := context.Background()
+
+	// Mock: o slug antigo aponta para o atual
+	mockRepo := new(MockMenuGetter)
+	mockRepo.On("GetBySlug", ctx, "pizza-do-joao").Return(nil, domain.ErrRestaurantNotFound)
+	mockRepo.On("GetCanonicalSlug", ctx, "pizza-do-joao").Return("pizzaria-joao", nil)
+
+	// Execute
+	uc := NewGetMenuUseCase(mockRepo)
+	output, err := uc.Execute(ctx, GetMenuInput{Slug: "pizza-do-joao"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Nil(t, output.Menu)
+	assert.Equal(t, "pizzaria-joao", output.CanonicalSlug)
+	mockRepo.AssertNotCalled(t, "ListMenuSections", mock.Anything, mock.Anything)
+}
+
+func TestGetMenuUseCase_Execute_UnpublishedRestaurant(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	statuses := []string{domain.StatusDraft, domain.StatusSuspended}
+
+	for _, status := range statuses {
+		// Mock
+		restaurant := &domain.Restaurant{ID: uuid.New(), Slug: "pizzaria-napoli", Status: status}
+		mockRepo := new(MockMenuGetter)
+		mockRepo.On("GetBySlug", ctx, "pizzaria-napoli").Return(restaurant, nil)
+
+		// Execute
+		uc := NewGetMenuUseCase(mockRepo)
+		output, err := uc.Execute(ctx, GetMenuInput{Slug: "pizzaria-napoli"})
+
+		// Assert
+		assert.Nil(t, output, status)
+		assert.ErrorIs(t, err, domain.ErrRestaurantNotFound, status)
+		mockRepo.AssertNotCalled(t, "ListMenuSections", mock.Anything, mock.Anything)
+	}
+}
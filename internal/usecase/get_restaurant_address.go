@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"gastro-go/internal/domain"
+)
+
+// RestaurantAddressGetter define a interface mínima necessária para buscar o endereço de restaurantes
+// Segue Interface Segregation Principle: apenas o método que este use case precisa
+type RestaurantAddressGetter interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error)
+}
+
+// GetRestaurantAddressUseCase implementa o caso de uso de buscar o endereço de um restaurante
+type GetRestaurantAddressUseCase struct {
+	repo RestaurantAddressGetter
+}
+
+// NewGetRestaurantAddressUseCase cria uma nova instância do use case
+func NewGetRestaurantAddressUseCase(repo RestaurantAddressGetter) *GetRestaurantAddressUseCase {
+	return &GetRestaurantAddressUseCase{
+		repo: repo,
+	}
+}
+
+// Execute executa o caso de uso de buscar endereço
+func (uc *GetRestaurantAddressUseCase) Execute(ctx context.Context, restaurantID uuid.UUID) (*domain.Address, error) {
+	restaurant, err := uc.repo.GetByID(ctx, restaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("get restaurant address usecase: %w", err)
+	}
+
+	if restaurant.Address == nil {
+		return nil, fmt.Errorf("get restaurant address usecase: %w", domain.ErrAddressNotFound)
+	}
+
+	return restaurant.Address, nil
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockRestaurantAddressGetter é um mock específico para RestaurantAddressGetter
+type MockRestaurantAddressGetter struct {
+	mock.Mock
+}
+
+func (m *MockRestaurantAddressGetter) GetByID(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Restaurant), args.Error(1)
+}
+
+func TestGetRestaurantAddressUseCase_Execute_Success(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	address := &domain.Address{RestaurantID: restaurantID, Street: "Rua Augusta", Number: "500", City: "São Paulo", State: "SP", ZipCode: "01305000"}
+
+	// Mock
+	mockRepo := new(MockRestaurantAddressGetter)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID, Address: address}, nil)
+
+	// Execute
+	uc := NewGetRestaurantAddressUseCase(mockRepo)
+	result, err := uc.Execute(ctx, restaurantID)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, address, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetRestaurantAddressUseCase_Execute_NoAddress(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+
+	// Mock
+	mockRepo := new(MockRestaurantAddressGetter)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID}, nil)
+
+	// Execute
+	uc := NewGetRestaurantAddressUseCase(mockRepo)
+	result, err := uc.Execute(ctx, restaurantID)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, domain.ErrAddressNotFound)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestGetRestaurantAddressUseCase_Execute_RestaurantNotFound(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+
+	// Mock
+	mockRepo := new(MockRestaurantAddressGetter)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(nil, domain.ErrRestaurantNotFound)
+
+	// Execute
+	uc := NewGetRestaurantAddressUseCase(mockRepo)
+	result, err := uc.Execute(ctx, restaurantID)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, domain.ErrRestaurantNotFound)
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,9 +10,10 @@ import (
 )
 
 // RestaurantGetterBySlug define a interface mínima necessária para buscar restaurante por slug
-// Segue Interface Segregation Principle: apenas o método que este use case precisa
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
 type RestaurantGetterBySlug interface {
 	GetBySlug(ctx context.Context, slug string) (*domain.Restaurant, error)
+	GetCanonicalSlug(ctx context.Context, slug string) (string, error)
 }
 
 // GetRestaurantBySlugUseCase implementa o caso de uso de buscar restaurante por slug
@@ -26,17 +28,31 @@ func NewGetRestaurantBySlugUseCase(repo RestaurantGetterBySlug) *GetRestaurantBy
 	}
 }
 
+// GetRestaurantBySlugOutput representa o resultado da busca por slug
+// Para um slug aposentado, Restaurant é nil e CanonicalSlug aponta para o slug atual
+type GetRestaurantBySlugOutput struct {
+	Restaurant    *domain.Restaurant
+	CanonicalSlug string
+}
+
 // Execute executa o caso de uso de buscar restaurante por slug
-func (uc *GetRestaurantBySlugUseCase) Execute(ctx context.Context, slug string) (*domain.Restaurant, error) {
+func (uc *GetRestaurantBySlugUseCase) Execute(ctx context.Context, slug string) (*GetRestaurantBySlugOutput, error) {
 	restaurant, err := uc.repo.GetBySlug(ctx, slug)
+	if errors.Is(err, domain.ErrRestaurantNotFound) {
+		// Links antigos (redes sociais, buscadores) continuam funcionando após a troca de slug
+		canonical, canonicalErr := uc.repo.GetCanonicalSlug(ctx, slug)
+		if canonicalErr != nil {
+			return nil, fmt.Errorf("get restaurant by slug usecase: %w", canonicalErr)
+		}
+		return &GetRestaurantBySlugOutput{CanonicalSlug: canonical}, nil
+	}
 	if err != nil {
 		return nil, fmt.Errorf("get restaurant by slug usecase: %w", err)
 	}
 
-	// Calcular IsOpen
+	// Calcular IsOpen e próximos horários
 	now := time.Now()
-	restaurant.IsOpen = restaurant.CalculateIsOpen(now)
+	restaurant.RefreshAvailability(now)
 
-	return restaurant, nil
+	return &GetRestaurantBySlugOutput{Restaurant: restaurant}, nil
 }
-
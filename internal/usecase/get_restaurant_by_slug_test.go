@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockRestaurantGetterBySlug é um mock específico para RestaurantGetterBySlug
+type MockRestaurantGetterBySlug struct {
+	mock.Mock
+}
+
+func (m *MockRestaurantGetterBySlug) GetBySlug(ctx context.Context, slug string) (*domain.Restaurant, error) {
+	args := m.Called(ctx, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Restaurant), args.Error(1)
+}
+
+func (m *MockRestaurantGetterBySlug) GetCanonicalSlug(ctx context.Context, slug string) (string, error) {
+	args := m.Called(ctx, slug)
+	return args.String(0), args.Error(1)
+}
+
+func TestGetRestaurantBySlugUseCase_Execute_RetiredSlug(t *testing.T) {
+	// Input
+	ctx := context.Background()
+
+	// Mock
+	mockRepo := new(MockRestaurantGetterBySlug)
+	mockRepo.On("GetBySlug", ctx, "pizza-do-joao").Return(nil, domain.ErrRestaurantNotFound)
+	mockRepo.On("GetCanonicalSlug", ctx, "pizza-do-joao").Return("pizzaria-joao", nil)
+
+	// Execute
+	uc := NewGetRestaurantBySlugUseCase(mockRepo)
+	output, err := uc.Execute(ctx, "pizza-do-joao")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Nil(t, output.Restaurant)
+	assert.Equal(t, "pizzaria-joao", output.CanonicalSlug)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetRestaurantBySlugUseCase_Execute_UnknownSlug(t *testing.T) {
+	// Input
+	ctx := context.Background()
+
+	// Mock
+	mockRepo := new(MockRestaurantGetterBySlug)
+	mockRepo.On("GetBySlug", ctx, "nao-existe").Return(nil, domain.ErrRestaurantNotFound)
+	mockRepo.On("GetCanonicalSlug", ctx, "nao-existe").Return("", domain.ErrRestaurantNotFound)
+
+	// Execute
+	uc := NewGetRestaurantBySlugUseCase(mockRepo)
+	output, err := uc.Execute(ctx, "nao-existe")
+
+	// Assert
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	mockRepo.AssertExpectations(t)
+}
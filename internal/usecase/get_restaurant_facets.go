@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gastro-go/internal/domain"
+)
+
+// RestaurantFacetReader define a interface mínima necessária para calcular as facetas da listagem
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
+type RestaurantFacetReader interface {
+	RestaurantLister
+	CountByCategory(ctx context.Context, filter domain.RestaurantFilter) ([]domain.FacetValue, error)
+	CountByCity(ctx context.Context, filter domain.RestaurantFilter) ([]domain.FacetValue, error)
+	CountByPaymentMethod(ctx context.Context, filter domain.RestaurantFilter) ([]domain.FacetValue, error)
+}
+
+// GetRestaurantFacetsUseCase implementa o caso de uso de contagens por filtro da descoberta
+type GetRestaurantFacetsUseCase struct {
+	repo RestaurantFacetReader
+	now  func() time.Time
+}
+
+// NewGetRestaurantFacetsUseCase cria uma nova instância do use case
+func NewGetRestaurantFacetsUseCase(repo RestaurantFacetReader) *GetRestaurantFacetsUseCase {
+	return &GetRestaurantFacetsUseCase{
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+// Execute calcula as facetas sob os mesmos filtros da listagem
+// Sem "entrega no ponto", cada faceta é uma agregação no banco, inclusive "aberto agora"
+func (uc *GetRestaurantFacetsUseCase) Execute(ctx context.Context, filter domain.RestaurantFilter) (*domain.RestaurantFacets, error) {
+	filter.Normalize()
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("get restaurant facets usecase: %w", err)
+	}
+
+	now := uc.now()
+	var facets domain.RestaurantFacets
+	var err error
+	if filter.HasComputedFilters() {
+		facets, err = uc.computeInMemory(ctx, filter, now)
+	} else {
+		facets, err = uc.aggregate(ctx, filter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get restaurant facets usecase: %w", err)
+	}
+
+	return &facets, nil
+}
+
+// aggregate calcula as facetas com as consultas agrupadas do banco
+func (uc *GetRestaurantFacetsUseCase) aggregate(ctx context.Context, filter domain.RestaurantFilter) (domain.RestaurantFacets, error) {
+	var facets domain.RestaurantFacets
+	var err error
+
+	if facets.Total, err = uc.repo.Count(ctx, filter); err != nil {
+		return facets, err
+	}
+
+	// Cada faceta ignora o próprio filtro para listar as alternativas disponíveis
+	withoutCategory := filter
+	withoutCategory.Category = ""
+	if facets.Categories, err = uc.repo.CountByCategory(ctx, withoutCategory); err != nil {
+		return facets, err
+	}
+	withoutCity := filter
+	withoutCity.City = ""
+	if facets.Cities, err = uc.repo.CountByCity(ctx, withoutCity); err != nil {
+		return facets, err
+	}
+	if facets.PaymentMethods, err = uc.repo.CountByPaymentMethod(ctx, filter); err != nil {
+		return facets, err
+	}
+
+	openNow := filter
+	openNow.OpenNow = true
+	if facets.OpenNow, err = uc.repo.Count(ctx, openNow); err != nil {
+		return facets, err
+	}
+
+	return facets, nil
+}
+
+// computeInMemory percorre uma única vez os restaurantes que atendem aos demais filtros
+// e agrega as facetas no domínio, já que delivers_to não é avaliado pelo banco
+func (uc *GetRestaurantFacetsUseCase) computeInMemory(ctx context.Context, filter domain.RestaurantFilter, now time.Time) (domain.RestaurantFacets, error) {
+	base := filter
+	base.Category = ""
+	base.City = ""
+	base.OpenNow = false
+
+	restaurants, resume, err := listComputed(ctx, uc.repo, base, domain.RestaurantSort{}, nil, 0)
+	if err != nil {
+		return domain.RestaurantFacets{}, err
+	}
+	if resume != nil {
+		return domain.RestaurantFacets{}, domain.ErrFilterTooBroad
+	}
+	return domain.ComputeFacets(restaurants, filter, now), nil
+}
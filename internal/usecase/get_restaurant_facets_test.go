@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockRestaurantFacetReader é um mock específico para RestaurantFacetReader
+type MockRestaurantFacetReader struct {
+	MockRestaurantLister
+}
+
+func (m *MockRestaurantFacetReader) CountByCategory(ctx context.Context, filter domain.RestaurantFilter) ([]domain.FacetValue, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]domain.FacetValue), args.Error(1)
+}
+
+func (m *MockRestaurantFacetReader) CountByCity(ctx context.Context, filter domain.RestaurantFilter) ([]domain.FacetValue, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]domain.FacetValue), args.Error(1)
+}
+
+func (m *MockRestaurantFacetReader) CountByPaymentMethod(ctx context.Context, filter domain.RestaurantFilter) ([]domain.FacetValue, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]domain.FacetValue), args.Error(1)
+}
+
+func TestGetRestaurantFacetsUseCase_Execute_Aggregates(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	filter := domain.RestaurantFilter{Category: "Pizza", City: "Campinas", State: "sp"}
+
+	// Mock: cada faceta ignora o próprio filtro; "aberto agora" também é contado pelo banco
+	applied := domain.RestaurantFilter{Category: "Pizza", City: "Campinas", State: "SP"}
+	withoutCategory := domain.RestaurantFilter{City: "Campinas", State: "SP"}
+	withoutCity := domain.RestaurantFilter{Category: "Pizza", State: "SP"}
+	openNow := domain.RestaurantFilter{Category: "Pizza", City: "Campinas", State: "SP", OpenNow: true}
+	mockRepo := new(MockRestaurantFacetReader)
+	mockRepo.On("Count", ctx, applied).Return(int64(3), nil)
+	mockRepo.On("CountByCategory", ctx, withoutCategory).Return([]domain.FacetValue{{Value: "pizza", Label: "Pizza", Count: 3}, {Value: "japonesa", Label: "Japonesa", Count: 2}}, nil)
+	mockRepo.On("CountByCity", ctx, withoutCity).Return([]domain.FacetValue{{Value: "Campinas", Count: 3}}, nil)
+	mockRepo.On("CountByPaymentMethod", ctx, applied).Return([]domain.FacetValue{{Value: "PIX", Count: 3}}, nil)
+	mockRepo.On("Count", ctx, openNow).Return(int64(1), nil)
+
+	// Execute
+	uc := NewGetRestaurantFacetsUseCase(mockRepo)
+	facets, err := uc.Execute(ctx, filter)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), facets.Total)
+	assert.Len(t, facets.Categories, 2)
+	assert.Equal(t, "Campinas", facets.Cities[0].Value)
+	assert.Equal(t, int64(3), facets.PaymentMethods[0].Count)
+	assert.Equal(t, int64(1), facets.OpenNow)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetRestaurantFacetsUseCase_Execute_ComputedFiltersScanOnce(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	point := domain.GeoPoint{Lat: -23.55, Lng: -46.63}
+	filter := domain.RestaurantFilter{Category: "pizza", DeliversTo: &point}
+	radius := 1000
+	lat, lng := point.Lat, point.Lng
+	address := &domain.Address{City: "São Paulo", Lat: &lat, Lng: &lng}
+	area := []domain.DeliveryArea{{Kind: domain.DeliveryAreaRadius, RadiusMeters: radius}}
+	pizzaCategory := []domain.Category{{Slug: "pizza", Name: "Pizza"}}
+	pizza := &domain.Restaurant{Category: "Pizza", Categories: pizzaCategory, SupportsDelivery: true, Address: address, DeliveryAreas: area,
+		PaymentMethods: []domain.PaymentMethod{{Method: "PIX"}}}
+	sushi := &domain.Restaurant{Category: "Japonesa", Categories: []domain.Category{{Slug: "japonesa", Name: "Japonesa"}}, SupportsDelivery: true, Address: address, DeliveryAreas: area}
+	outside := &domain.Restaurant{Category: "Pizza", Categories: pizzaCategory, SupportsDelivery: true, Address: address}
+
+	// Mock: uma única varredura sem os filtros de categoria/cidade/aberto agora
+	base := domain.RestaurantFilter{DeliversTo: &point}
+	mockRepo := new(MockRestaurantFacetReader)
+	mockRepo.On("List", ctx, base, domain.RestaurantSort{}, (*domain.ListCursor)(nil), int32(computedFilterBatchSize), int32(0)).Return([]*domain.Restaurant{pizza, sushi, outside}, nil)
+
+	// Execute
+	uc := NewGetRestaurantFacetsUseCase(mockRepo)
+	facets, err := uc.Execute(ctx, filter)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), facets.Total)
+	assert.Equal(t, []domain.FacetValue{{Value: "japonesa", Label: "Japonesa", Count: 1}, {Value: "pizza", Label: "Pizza", Count: 1}}, facets.Categories)
+	assert.Equal(t, []domain.FacetValue{{Value: "São Paulo", Count: 1}}, facets.Cities)
+	assert.Equal(t, []domain.FacetValue{{Value: "PIX", Count: 1}}, facets.PaymentMethods)
+	mockRepo.AssertNotCalled(t, "CountByCategory", mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
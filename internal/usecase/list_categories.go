@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"gastro-go/internal/domain"
+)
+
+// CategoryLister define a interface mínima necessária para listar categorias
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
+type CategoryLister interface {
+	ListCategories(ctx context.Context) ([]domain.Category, error)
+}
+
+// ListCategoriesUseCase implementa o caso de uso de listagem da taxonomia
+type ListCategoriesUseCase struct {
+	repo CategoryLister
+}
+
+// NewListCategoriesUseCase cria uma nova instância do use case
+func NewListCategoriesUseCase(repo CategoryLister) *ListCategoriesUseCase {
+	return &ListCategoriesUseCase{
+		repo: repo,
+	}
+}
+
+// Execute lista as categorias na ordem de exibição
+func (uc *ListCategoriesUseCase) Execute(ctx context.Context) ([]domain.Category, error) {
+	categories, err := uc.repo.ListCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list categories usecase: %w", err)
+	}
+	return categories, nil
+}
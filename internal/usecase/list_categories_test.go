@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockCategoryLister é um mock específico para CategoryLister
+type MockCategoryLister struct {
+	mock.Mock
+}
+
+func (m *MockCategoryLister) ListCategories(ctx context.Context) ([]domain.Category, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Category), args.Error(1)
+}
+
+func TestListCategoriesUseCase_Execute_Success(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	pizza := domain.Category{ID: uuid.New(), Slug: "pizza", Name: "Pizza", Position: 1}
+	sushi := domain.Category{ID: uuid.New(), Slug: "japonesa", Name: "Japonesa", Position: 2}
+
+	// Mock
+	mockRepo := new(MockCategoryLister)
+	mockRepo.On("ListCategories", ctx).Return([]domain.Category{pizza, sushi}, nil)
+
+	// Execute
+	uc := NewListCategoriesUseCase(mockRepo)
+	categories, err := uc.Execute(ctx)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Category{pizza, sushi}, categories)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestListCategoriesUseCase_Execute_RepositoryError(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	dbErr := errors.New("connection refused")
+
+	// Mock
+	mockRepo := new(MockCategoryLister)
+	mockRepo.On("ListCategories", ctx).Return(nil, dbErr)
+
+	// Execute
+	uc := NewListCategoriesUseCase(mockRepo)
+	categories, err := uc.Execute(ctx)
+
+	// Assert
+	assert.Nil(t, categories)
+	assert.ErrorIs(t, err, dbErr)
+	mockRepo.AssertExpectations(t)
+}
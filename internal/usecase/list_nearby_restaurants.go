@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gastro-go/internal/domain"
+)
+
+const (
+	// defaultNearbyLimit e maxNearbyLimit limitam o tamanho da resposta da busca por proximidade
+	defaultNearbyLimit = 20
+	maxNearbyLimit     = 100
+)
+
+// NearbyRestaurantLister define a interface mínima necessária para buscar restaurantes próximos
+// Segue Interface Segregation Principle: apenas o método que este use case precisa
+type NearbyRestaurantLister interface {
+	ListNearby(ctx context.Context, search domain.NearbySearch, limit int32) ([]*domain.Restaurant, error)
+}
+
+// ListNearbyRestaurantsUseCase implementa a busca de restaurantes por proximidade
+type ListNearbyRestaurantsUseCase struct {
+	repo NearbyRestaurantLister
+	now  func() time.Time
+}
+
+// NewListNearbyRestaurantsUseCase cria uma nova instância do use case
+func NewListNearbyRestaurantsUseCase(repo NearbyRestaurantLister) *ListNearbyRestaurantsUseCase {
+	return &ListNearbyRestaurantsUseCase{
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+// ListNearbyRestaurantsInput representa os dados de entrada da busca por proximidade
+type ListNearbyRestaurantsInput struct {
+	Search domain.NearbySearch
+	Limit  int32
+}
+
+// ListNearbyRestaurantsOutput representa o resultado da busca por proximidade
+type ListNearbyRestaurantsOutput struct {
+	Items []*domain.Restaurant // Ordenados pela distância
+	Limit int32
+}
+
+// Execute retorna os restaurantes do raio ordenados pela distância, com IsOpen calculado
+func (uc *ListNearbyRestaurantsUseCase) Execute(ctx context.Context, input ListNearbyRestaurantsInput) (*ListNearbyRestaurantsOutput, error) {
+	if input.Limit <= 0 {
+		input.Limit = defaultNearbyLimit
+	}
+	if input.Limit > maxNearbyLimit {
+		input.Limit = maxNearbyLimit
+	}
+
+	if err := input.Search.Validate(); err != nil {
+		return nil, fmt.Errorf("list nearby restaurants usecase: %w", err)
+	}
+
+	// "Aberto agora" é filtrado no banco, no fuso de cada restaurante, sem perder a ordem por distância
+	restaurants, err := uc.repo.ListNearby(ctx, input.Search, input.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("list nearby restaurants usecase: %w", err)
+	}
+
+	now := uc.now()
+	for _, restaurant := range restaurants {
+		restaurant.RefreshAvailability(now)
+	}
+
+	return &ListNearbyRestaurantsOutput{Items: restaurants, Limit: input.Limit}, nil
+}
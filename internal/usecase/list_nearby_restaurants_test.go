@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockNearbyRestaurantLister é um mock específico para NearbyRestaurantLister
+type MockNearbyRestaurantLister struct {
+	mock.Mock
+}
+
+func (m *MockNearbyRestaurantLister) ListNearby(ctx context.Context, search domain.NearbySearch, limit int32) ([]*domain.Restaurant, error) {
+	args := m.Called(ctx, search, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Restaurant), args.Error(1)
+}
+
+func TestListNearbyRestaurantsUseCase_Execute_Defaults(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := ListNearbyRestaurantsInput{
+		Search: domain.NearbySearch{Lat: -23.55, Lng: -46.63},
+	}
+	expectedSearch := domain.NearbySearch{Lat: -23.55, Lng: -46.63, RadiusKm: domain.DefaultNearbyRadiusKm}
+	distance := 120.5
+	restaurants := []*domain.Restaurant{
+		{Name: "Perto", Status: domain.StatusClosed, DistanceMeters: &distance},
+	}
+
+	// Mock
+	mockRepo := new(MockNearbyRestaurantLister)
+	mockRepo.On("ListNearby", ctx, expectedSearch, int32(defaultNearbyLimit)).Return(restaurants, nil)
+
+	// Execute
+	uc := NewListNearbyRestaurantsUseCase(mockRepo)
+	output, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int32(defaultNearbyLimit), output.Limit)
+	assert.Len(t, output.Items, 1)
+	assert.False(t, output.Items[0].IsOpen)
+	assert.Equal(t, 120.5, *output.Items[0].DistanceMeters)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestListNearbyRestaurantsUseCase_Execute_OpenNowFilteredByDatabase(t *testing.T) {
+	// Input: segunda-feira 15:00 em São Paulo
+	ctx := context.Background()
+	now := time.Date(2024, time.March, 4, 18, 0, 0, 0, time.UTC)
+	input := ListNearbyRestaurantsInput{
+		Search: domain.NearbySearch{Lat: -23.55, Lng: -46.63, RadiusKm: 2, OpenNow: true},
+		Limit:  1,
+	}
+	allDay := []domain.OpeningHour{{Weekday: int(time.Monday), OpensAt: 0, ClosesAt: 23*60 + 59}}
+	openNear := &domain.Restaurant{Name: "B", Status: domain.StatusOpen, Timezone: "America/Sao_Paulo", OpeningHours: allDay}
+
+	// Mock: o banco já aplica open_now e o limite, sem varredura extra
+	mockRepo := new(MockNearbyRestaurantLister)
+	mockRepo.On("ListNearby", ctx, input.Search, int32(1)).Return([]*domain.Restaurant{openNear}, nil).Once()
+
+	// Execute
+	uc := NewListNearbyRestaurantsUseCase(mockRepo)
+	uc.now = func() time.Time { return now }
+	output, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, output.Items, 1)
+	assert.Equal(t, "B", output.Items[0].Name)
+	assert.True(t, output.Items[0].IsOpen)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestListNearbyRestaurantsUseCase_Execute_InvalidSearch(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := ListNearbyRestaurantsInput{
+		Search: domain.NearbySearch{Lat: -23.55, Lng: -46.63, RadiusKm: 500},
+	}
+
+	// Mock
+	mockRepo := new(MockNearbyRestaurantLister)
+
+	// Execute
+	uc := NewListNearbyRestaurantsUseCase(mockRepo)
+	output, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	mockRepo.AssertNotCalled(t, "ListNearby", mock.Anything, mock.Anything, mock.Anything)
+}
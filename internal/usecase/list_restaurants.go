@@ -8,32 +8,59 @@ import (
 	"gastro-go/internal/domain"
 )
 
+const (
+	// computedFilterBatchSize é o tamanho dos lotes lidos do banco ao aplicar filtros em memória
+	computedFilterBatchSize = 100
+	// computedFilterScanLimit limita quantos restaurantes uma única requisição lê do banco
+	// para avaliar delivers_to; sem ele, um filtro seletivo percorreria a tabela inteira
+	computedFilterScanLimit = 1000
+)
+
 // RestaurantLister define a interface mínima necessária para listar restaurantes
-// Segue Interface Segregation Principle: apenas o método que este use case precisa
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
 type RestaurantLister interface {
-	List(ctx context.Context, limit, offset int32) ([]*domain.Restaurant, error)
+	List(ctx context.Context, filter domain.RestaurantFilter, sort domain.RestaurantSort, cursor *domain.ListCursor, limit, offset int32) ([]*domain.Restaurant, error)
+	Count(ctx context.Context, filter domain.RestaurantFilter) (int64, error)
 }
 
 // ListRestaurantsUseCase implementa o caso de uso de listagem de restaurantes
 type ListRestaurantsUseCase struct {
 	repo RestaurantLister
+	now  func() time.Time
 }
 
 // NewListRestaurantsUseCase cria uma nova instância do use case
 func NewListRestaurantsUseCase(repo RestaurantLister) *ListRestaurantsUseCase {
 	return &ListRestaurantsUseCase{
 		repo: repo,
+		now:  time.Now,
 	}
 }
 
 // ListRestaurantsInput representa os dados de entrada para listar restaurantes
+// Cursor (keyset) e Offset são modos alternativos de paginação; não podem ser combinados
+// Um cursor só vale para o mesmo Sort em que foi gerado
 type ListRestaurantsInput struct {
-	Limit  int32
-	Offset int32
+	Filter       domain.RestaurantFilter
+	Sort         string           // Campo da whitelist, "-" para decrescente (ex: "-rating")
+	Origin       *domain.GeoPoint // Ponto de referência; obrigatório com sort=distance
+	Limit        int32
+	Offset       int32
+	Cursor       string // Token opaco recebido em NextCursor
+	IncludeTotal bool   // Conta o total de resultados (consulta extra)
+}
+
+// ListRestaurantsOutput representa uma página da listagem de restaurantes
+type ListRestaurantsOutput struct {
+	Items      []*domain.Restaurant
+	Limit      int32
+	Offset     int32
+	NextCursor string // Vazio na última página
+	Total      *int64 // Preenchido apenas com IncludeTotal
 }
 
 // Execute executa o caso de uso de listagem de restaurantes
-func (uc *ListRestaurantsUseCase) Execute(ctx context.Context, input ListRestaurantsInput) ([]*domain.Restaurant, error) {
+func (uc *ListRestaurantsUseCase) Execute(ctx context.Context, input ListRestaurantsInput) (*ListRestaurantsOutput, error) {
 	if input.Limit <= 0 {
 		input.Limit = 20 // Default
 	}
@@ -41,17 +68,137 @@ func (uc *ListRestaurantsUseCase) Execute(ctx context.Context, input ListRestaur
 		input.Offset = 0
 	}
 
-	restaurants, err := uc.repo.List(ctx, input.Limit, input.Offset)
+	input.Filter.Normalize()
+	if err := input.Filter.Validate(); err != nil {
+		return nil, fmt.Errorf("list restaurants usecase: %w", err)
+	}
+
+	sort, err := domain.ParseRestaurantSort(input.Sort)
 	if err != nil {
 		return nil, fmt.Errorf("list restaurants usecase: %w", err)
 	}
+	sort.Origin = input.Origin
+	if err := sort.Validate(); err != nil {
+		return nil, fmt.Errorf("list restaurants usecase: %w", err)
+	}
+
+	var cursor *domain.ListCursor
+	if input.Cursor != "" {
+		if input.Offset > 0 {
+			return nil, fmt.Errorf("list restaurants usecase: %w", domain.NewValidationError("cursor_with_offset", "cursor and offset cannot be combined"))
+		}
+		decoded, err := domain.DecodeCursor(input.Cursor)
+		if err != nil {
+			return nil, fmt.Errorf("list restaurants usecase: %w", err)
+		}
+		if err := decoded.CheckSort(sort); err != nil {
+			return nil, fmt.Errorf("list restaurants usecase: %w", err)
+		}
+		cursor = decoded
+	}
+
+	now := uc.now()
+	var restaurants []*domain.Restaurant
+	var resume *domain.ListCursor
+	if input.Filter.HasComputedFilters() {
+		restaurants, resume, err = listComputed(ctx, uc.repo, input.Filter, sort, cursor, int(input.Offset+input.Limit+1))
+		if len(restaurants) > int(input.Offset) {
+			restaurants = restaurants[input.Offset:]
+		} else {
+			restaurants = nil
+		}
+	} else {
+		// Buscar um a mais para saber se existe próxima página
+		restaurants, err = uc.repo.List(ctx, input.Filter, sort, cursor, input.Limit+1, input.Offset)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("list restaurants usecase: %w", err)
+	}
+
+	output := &ListRestaurantsOutput{
+		Items:  restaurants,
+		Limit:  input.Limit,
+		Offset: input.Offset,
+	}
+	if output.Items == nil {
+		output.Items = []*domain.Restaurant{}
+	}
+	if len(output.Items) > int(input.Limit) {
+		output.Items = output.Items[:input.Limit]
+		next := domain.CursorFor(output.Items[len(output.Items)-1], sort)
+		resume = &next
+	}
+	// Sem página cheia, a varredura pode ter parado no limite: a página vem curta (ou vazia)
+	// e o cursor retoma a partir do último restaurante lido
+	if resume != nil {
+		if output.NextCursor, err = resume.Encode(); err != nil {
+			return nil, fmt.Errorf("list restaurants usecase: %w", err)
+		}
+	}
 
-	// Calcular IsOpen para cada restaurante
-	now := time.Now()
-	for _, restaurant := range restaurants {
-		restaurant.IsOpen = restaurant.CalculateIsOpen(now)
+	// Calcular IsOpen e próximos horários para cada restaurante
+	for _, restaurant := range output.Items {
+		restaurant.RefreshAvailability(now)
 	}
 
-	return restaurants, nil
+	if input.IncludeTotal {
+		total, err := uc.count(ctx, input.Filter)
+		if err != nil {
+			return nil, fmt.Errorf("list restaurants usecase: %w", err)
+		}
+		output.Total = &total
+	}
+
+	return output, nil
 }
 
+// count conta os restaurantes que atendem ao filtro, desconsiderando a paginação
+func (uc *ListRestaurantsUseCase) count(ctx context.Context, filter domain.RestaurantFilter) (int64, error) {
+	if !filter.HasComputedFilters() {
+		return uc.repo.Count(ctx, filter)
+	}
+
+	matches, resume, err := listComputed(ctx, uc.repo, filter, domain.RestaurantSort{}, nil, 0)
+	if err != nil {
+		return 0, err
+	}
+	if resume != nil {
+		return 0, domain.ErrFilterTooBroad
+	}
+	return int64(len(matches)), nil
+}
+
+// listComputed percorre, a partir do cursor, os restaurantes que atendem aos filtros em memória
+// "Entrega no ponto" depende das áreas (inclusive polígonos) de cada restaurante, então o banco
+// pré-filtra e os lotes são avaliados aqui. Para após want resultados (0 = todos)
+// Lê no máximo computedFilterScanLimit restaurantes; se parar no limite, retorna também a posição
+// do último restaurante lido para que a varredura seja retomada em outra requisição
+func listComputed(ctx context.Context, repo RestaurantLister, filter domain.RestaurantFilter, sort domain.RestaurantSort, cursor *domain.ListCursor, want int) ([]*domain.Restaurant, *domain.ListCursor, error) {
+	var restaurants []*domain.Restaurant
+	for scanned := 0; ; {
+		batch, err := repo.List(ctx, filter, sort, cursor, computedFilterBatchSize, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		scanned += len(batch)
+
+		for _, restaurant := range batch {
+			if !filter.MatchesComputed(restaurant) {
+				continue
+			}
+			restaurants = append(restaurants, restaurant)
+			if want > 0 && len(restaurants) == want {
+				return restaurants, nil, nil
+			}
+		}
+
+		if len(batch) < computedFilterBatchSize {
+			return restaurants, nil, nil
+		}
+		next := domain.CursorFor(batch[len(batch)-1], sort)
+		if scanned >= computedFilterScanLimit {
+			return restaurants, &next, nil
+		}
+		cursor = &next
+	}
+}
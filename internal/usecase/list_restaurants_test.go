@@ -3,10 +3,12 @@ package usecase
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"gastro-go/internal/domain"
 )
@@ -18,14 +20,19 @@ type MockRestaurantLister struct {
 	mock.Mock
 }
 
-func (m *MockRestaurantLister) List(ctx context.Context, limit, offset int32) ([]*domain.Restaurant, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *MockRestaurantLister) List(ctx context.Context, filter domain.RestaurantFilter, sort domain.RestaurantSort, cursor *domain.ListCursor, limit, offset int32) ([]*domain.Restaurant, error) {
+	args := m.Called(ctx, filter, sort, cursor, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*domain.Restaurant), args.Error(1)
 }
 
+func (m *MockRestaurantLister) Count(ctx context.Context, filter domain.RestaurantFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func TestListRestaurantsUseCase_Execute_Success(t *testing.T) {
 	// Input
 	ctx := context.Background()
@@ -71,21 +78,23 @@ func TestListRestaurantsUseCase_Execute_Success(t *testing.T) {
 
 	// Mock
 	mockRepo := new(MockRestaurantLister)
-	mockRepo.On("List", ctx, int32(10), int32(0)).Return([]*domain.Restaurant{restaurant1, restaurant2}, nil)
+	mockRepo.On("List", ctx, domain.RestaurantFilter{}, domain.RestaurantSort{}, (*domain.ListCursor)(nil), int32(11), int32(0)).Return([]*domain.Restaurant{restaurant1, restaurant2}, nil)
 
 	// Execute
 	uc := NewListRestaurantsUseCase(mockRepo)
-	restaurants, err := uc.Execute(ctx, input)
+	output, err := uc.Execute(ctx, input)
 
 	// Assert
 	assert.NoError(t, err)
-	assert.NotNil(t, restaurants)
-	assert.Len(t, restaurants, 2)
-	assert.Equal(t, "Pizza do João", restaurants[0].Name)
-	assert.Equal(t, "Burgers King", restaurants[1].Name)
+	assert.NotNil(t, output)
+	assert.Len(t, output.Items, 2)
+	assert.Equal(t, "Pizza do João", output.Items[0].Name)
+	assert.Equal(t, "Burgers King", output.Items[1].Name)
+	assert.Empty(t, output.NextCursor)
+	assert.Nil(t, output.Total)
 	// IsOpen deve ser calculado
-	assert.NotNil(t, restaurants[0].IsOpen)
-	assert.NotNil(t, restaurants[1].IsOpen)
+	assert.NotNil(t, output.Items[0].IsOpen)
+	assert.NotNil(t, output.Items[1].IsOpen)
 	mockRepo.AssertExpectations(t)
 }
 
@@ -99,15 +108,275 @@ func TestListRestaurantsUseCase_Execute_DefaultValues(t *testing.T) {
 
 	// Mock
 	mockRepo := new(MockRestaurantLister)
-	mockRepo.On("List", ctx, int32(20), int32(0)).Return([]*domain.Restaurant{}, nil)
+	mockRepo.On("List", ctx, domain.RestaurantFilter{}, domain.RestaurantSort{}, (*domain.ListCursor)(nil), int32(21), int32(0)).Return([]*domain.Restaurant{}, nil)
+
+	// Execute
+	uc := NewListRestaurantsUseCase(mockRepo)
+	output, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, output.Items)
+	assert.Equal(t, int32(20), output.Limit)
+	assert.Equal(t, int32(0), output.Offset)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestListRestaurantsUseCase_Execute_NormalizesAndValidatesFilter(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := ListRestaurantsInput{
+		Filter: domain.RestaurantFilter{Status: "paused"},
+	}
+
+	// Mock
+	mockRepo := new(MockRestaurantLister)
+
+	// Execute
+	uc := NewListRestaurantsUseCase(mockRepo)
+	output, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	mockRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestListRestaurantsUseCase_Execute_OpenNowFilteredByDatabase(t *testing.T) {
+	// Input: segunda-feira 12:00 em São Paulo
+	ctx := context.Background()
+	input := ListRestaurantsInput{
+		Filter: domain.RestaurantFilter{OpenNow: true, State: "sp"},
+		Limit:  1,
+		Offset: 1,
+	}
+	lunch := []domain.OpeningHour{{Weekday: int(time.Monday), OpensAt: 11 * 60, ClosesAt: 15 * 60}}
+	openC := &domain.Restaurant{Name: "C", Status: domain.StatusOpen, Timezone: "America/Sao_Paulo", OpeningHours: lunch}
+
+	// Mock: o banco já aplica open_now, a página segue a paginação comum
+	expectedFilter := domain.RestaurantFilter{OpenNow: true, State: "SP"}
+	mockRepo := new(MockRestaurantLister)
+	mockRepo.On("List", ctx, expectedFilter, domain.RestaurantSort{}, (*domain.ListCursor)(nil), int32(2), int32(1)).Return([]*domain.Restaurant{openC}, nil)
+
+	// Execute
+	uc := NewListRestaurantsUseCase(mockRepo)
+	uc.now = func() time.Time { return time.Date(2024, time.March, 4, 15, 0, 0, 0, time.UTC) }
+	output, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, output.Items, 1)
+	assert.Equal(t, "C", output.Items[0].Name)
+	assert.True(t, output.Items[0].IsOpen)
+	assert.Empty(t, output.NextCursor)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestListRestaurantsUseCase_Execute_CursorPagination(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	createdAt := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	cursor := domain.ListCursor{CreatedAt: createdAt, ID: uuid.New()}
+	input := ListRestaurantsInput{
+		Limit:        2,
+		Cursor:       encodeCursor(t, cursor),
+		IncludeTotal: true,
+	}
+	first := &domain.Restaurant{ID: uuid.New(), Name: "A", CreatedAt: createdAt.Add(-time.Hour)}
+	second := &domain.Restaurant{ID: uuid.New(), Name: "B", CreatedAt: createdAt.Add(-2 * time.Hour)}
+	extra := &domain.Restaurant{ID: uuid.New(), Name: "C", CreatedAt: createdAt.Add(-3 * time.Hour)}
+
+	// Mock
+	mockRepo := new(MockRestaurantLister)
+	mockRepo.On("List", ctx, domain.RestaurantFilter{}, domain.RestaurantSort{}, &cursor, int32(3), int32(0)).Return([]*domain.Restaurant{first, second, extra}, nil)
+	mockRepo.On("Count", ctx, domain.RestaurantFilter{}).Return(int64(7), nil)
+
+	// Execute
+	uc := NewListRestaurantsUseCase(mockRepo)
+	output, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, output.Items, 2)
+	assert.Equal(t, encodeCursor(t, domain.CursorFor(second, domain.RestaurantSort{})), output.NextCursor)
+	assert.Equal(t, int64(7), *output.Total)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestListRestaurantsUseCase_Execute_InvalidCursor(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	tests := []ListRestaurantsInput{
+		{Cursor: "not-a-cursor"},
+		{Cursor: encodeCursor(t, domain.ListCursor{CreatedAt: time.Now(), ID: uuid.New()}), Offset: 10},
+	}
+
+	for _, input := range tests {
+		// Mock
+		mockRepo := new(MockRestaurantLister)
+
+		// Execute
+		uc := NewListRestaurantsUseCase(mockRepo)
+		output, err := uc.Execute(ctx, input)
+
+		// Assert
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	}
+}
+
+func TestListRestaurantsUseCase_Execute_DeliversToFilter(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	point := domain.GeoPoint{Lat: -23.5650, Lng: -46.6600}
+	input := ListRestaurantsInput{
+		Filter: domain.RestaurantFilter{DeliversTo: &point},
+		Limit:  10,
+	}
+	lat, lng := -23.5614, -46.6559
+	radius := []domain.DeliveryArea{{Kind: domain.DeliveryAreaRadius, RadiusMeters: 2000}}
+	delivers := &domain.Restaurant{ID: uuid.New(), Name: "Perto", SupportsDelivery: true, Address: &domain.Address{Lat: &lat, Lng: &lng}, DeliveryAreas: radius}
+	tooSmall := &domain.Restaurant{ID: uuid.New(), Name: "Raio curto", SupportsDelivery: true, Address: &domain.Address{Lat: &lat, Lng: &lng},
+		DeliveryAreas: []domain.DeliveryArea{{Kind: domain.DeliveryAreaRadius, RadiusMeters: 100}}}
+
+	// Mock
+	mockRepo := new(MockRestaurantLister)
+	mockRepo.On("List", ctx, input.Filter, domain.RestaurantSort{}, (*domain.ListCursor)(nil), int32(computedFilterBatchSize), int32(0)).Return([]*domain.Restaurant{tooSmall, delivers}, nil)
+
+	// Execute
+	uc := NewListRestaurantsUseCase(mockRepo)
+	output, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, output.Items, 1)
+	assert.Equal(t, "Perto", output.Items[0].Name)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestListRestaurantsUseCase_Execute_ComputedFilterScanLimit(t *testing.T) {
+	// Input: nenhum restaurante entrega no ponto; a varredura para no limite
+	ctx := context.Background()
+	point := domain.GeoPoint{Lat: -23.5650, Lng: -46.6600}
+	input := ListRestaurantsInput{
+		Filter: domain.RestaurantFilter{DeliversTo: &point},
+		Limit:  10,
+	}
+	batch := make([]*domain.Restaurant, computedFilterBatchSize)
+	for i := range batch {
+		batch[i] = &domain.Restaurant{ID: uuid.New(), SupportsDelivery: true}
+	}
+
+	// Mock
+	mockRepo := new(MockRestaurantLister)
+	mockRepo.On("List", ctx, input.Filter, domain.RestaurantSort{}, mock.Anything, int32(computedFilterBatchSize), int32(0)).Return(batch, nil)
+
+	// Execute
+	uc := NewListRestaurantsUseCase(mockRepo)
+	output, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, output.Items)
+	assert.NotEmpty(t, output.NextCursor)
+	mockRepo.AssertNumberOfCalls(t, "List", computedFilterScanLimit/computedFilterBatchSize)
+
+	// Execute: o total não pode ser contado sem percorrer todos
+	input.IncludeTotal = true
+	output, err = uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, domain.ErrFilterTooBroad)
+}
+
+func TestListRestaurantsUseCase_Execute_SearchCursorCarriesRank(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := ListRestaurantsInput{
+		Filter: domain.RestaurantFilter{Query: "  pizzaria joao "},
+		Limit:  1,
+	}
+	expectedFilter := domain.RestaurantFilter{Query: "pizzaria joao"}
+	bestRank, otherRank := float32(0.6), float32(0.1)
+	best := &domain.Restaurant{ID: uuid.New(), Name: "Pizzaria do João", SearchRank: &bestRank}
+	other := &domain.Restaurant{ID: uuid.New(), Name: "Pizzaria Bella", SearchRank: &otherRank}
+
+	// Mock
+	mockRepo := new(MockRestaurantLister)
+	mockRepo.On("List", ctx, expectedFilter, domain.RestaurantSort{}, (*domain.ListCursor)(nil), int32(2), int32(0)).Return([]*domain.Restaurant{best, other}, nil)
+
+	// Execute
+	uc := NewListRestaurantsUseCase(mockRepo)
+	output, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, output.Items, 1)
+	cursor, err := domain.DecodeCursor(output.NextCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, bestRank, cursor.Rank)
+	assert.Equal(t, best.ID, cursor.ID)
+}
+
+func TestListRestaurantsUseCase_Execute_SortCursor(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	sort := domain.RestaurantSort{Field: domain.SortRating, Desc: true}
+	cursor := domain.ListCursor{Sort: "-rating", SortNumber: -5, ID: uuid.New()}
+	input := ListRestaurantsInput{Sort: "-rating", Limit: 1, Cursor: encodeCursor(t, cursor)}
+	first := &domain.Restaurant{ID: uuid.New(), Name: "A", Rating: 4}
+	extra := &domain.Restaurant{ID: uuid.New(), Name: "B", Rating: 4}
+
+	// Mock
+	mockRepo := new(MockRestaurantLister)
+	mockRepo.On("List", ctx, domain.RestaurantFilter{}, sort, &cursor, int32(2), int32(0)).Return([]*domain.Restaurant{first, extra}, nil)
 
 	// Execute
 	uc := NewListRestaurantsUseCase(mockRepo)
-	restaurants, err := uc.Execute(ctx, input)
+	output, err := uc.Execute(ctx, input)
 
 	// Assert
 	assert.NoError(t, err)
-	assert.NotNil(t, restaurants)
+	assert.Len(t, output.Items, 1)
+	next, err := domain.DecodeCursor(output.NextCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, "-rating", next.Sort)
+	assert.Equal(t, -4.0, next.SortNumber)
+	assert.Equal(t, first.ID, next.ID)
 	mockRepo.AssertExpectations(t)
 }
 
+func TestListRestaurantsUseCase_Execute_InvalidSort(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	tests := []ListRestaurantsInput{
+		{Sort: "created_at; drop table restaurants"},
+		{Sort: "distance"},
+		{Sort: "-rating", Cursor: encodeCursor(t, domain.ListCursor{CreatedAt: time.Now(), ID: uuid.New()})},
+		{Sort: "distance", Origin: &domain.GeoPoint{Lat: -23.55, Lng: -46.63},
+			Cursor: encodeCursor(t, domain.ListCursor{Sort: "distance", Origin: &domain.GeoPoint{Lat: -22.90, Lng: -43.17}, ID: uuid.New()})},
+	}
+
+	// Mock
+	mockRepo := new(MockRestaurantLister)
+
+	// Execute
+	uc := NewListRestaurantsUseCase(mockRepo)
+	for _, input := range tests {
+		output, err := uc.Execute(ctx, input)
+
+		// Assert
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	}
+	mockRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// encodeCursor gera o token do cursor, falhando o teste se não for serializável
+func encodeCursor(t *testing.T, cursor domain.ListCursor) string {
+	t.Helper()
+	token, err := cursor.Encode()
+	require.NoError(t, err)
+	return token
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"gastro-go/internal/domain"
+)
+
+// maxSpecialHoursRange limita o intervalo de datas consultado de uma vez
+const maxSpecialHoursRange = 366 * 24 * time.Hour
+
+// SpecialHoursLister define a interface mínima necessária para listar exceções de horário
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
+type SpecialHoursLister interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error)
+	ListSpecialHours(ctx context.Context, restaurantID uuid.UUID, from, to string) ([]*domain.SpecialHours, error)
+}
+
+// ListSpecialHoursUseCase implementa o caso de uso de listar exceções de horário
+type ListSpecialHoursUseCase struct {
+	repo SpecialHoursLister
+	now  func() time.Time
+}
+
+// NewListSpecialHoursUseCase cria uma nova instância do use case
+func NewListSpecialHoursUseCase(repo SpecialHoursLister) *ListSpecialHoursUseCase {
+	return &ListSpecialHoursUseCase{
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+// ListSpecialHoursInput representa os dados de entrada para listar exceções
+// From vazio = hoje no fuso do restaurante; To vazio = From + 1 ano
+type ListSpecialHoursInput struct {
+	RestaurantID uuid.UUID
+	From         string
+	To           string
+}
+
+// Execute executa o caso de uso de listar exceções de horário
+func (uc *ListSpecialHoursUseCase) Execute(ctx context.Context, input ListSpecialHoursInput) ([]*domain.SpecialHours, error) {
+	restaurant, err := uc.repo.GetByID(ctx, input.RestaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("list special hours usecase: %w", err)
+	}
+
+	if input.From == "" {
+		input.From = restaurant.LocalTime(uc.now()).Format(domain.DateLayout)
+	}
+	from, err := domain.ParseDate(input.From)
+	if err != nil {
+		return nil, fmt.Errorf("list special hours usecase: %w", err)
+	}
+
+	if input.To == "" {
+		input.To = from.AddDate(1, 0, 0).Format(domain.DateLayout)
+	}
+	to, err := domain.ParseDate(input.To)
+	if err != nil {
+		return nil, fmt.Errorf("list special hours usecase: %w", err)
+	}
+
+	if to.Before(from) {
+		return nil, fmt.Errorf("list special hours usecase: %w", domain.NewValidationError("invalid_date_range", "to must not be before from"))
+	}
+	if to.Sub(from) > maxSpecialHoursRange {
+		return nil, fmt.Errorf("list special hours usecase: %w", domain.NewValidationError("invalid_date_range", "date range cannot exceed one year"))
+	}
+
+	specials, err := uc.repo.ListSpecialHours(ctx, input.RestaurantID, input.From, input.To)
+	if err != nil {
+		return nil, fmt.Errorf("list special hours usecase: %w", err)
+	}
+
+	return specials, nil
+}
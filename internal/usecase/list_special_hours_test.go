@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockSpecialHoursLister é um mock específico para SpecialHoursLister
+type MockSpecialHoursLister struct {
+	mock.Mock
+}
+
+func (m *MockSpecialHoursLister) GetByID(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Restaurant), args.Error(1)
+}
+
+func (m *MockSpecialHoursLister) ListSpecialHours(ctx context.Context, restaurantID uuid.UUID, from, to string) ([]*domain.SpecialHours, error) {
+	args := m.Called(ctx, restaurantID, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.SpecialHours), args.Error(1)
+}
+
+func TestListSpecialHoursUseCase_Execute_DefaultRangeInRestaurantTimezone(t *testing.T) {
+	// Input: 31/12/2024 01:00 UTC ainda é 30/12 em São Paulo
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := ListSpecialHoursInput{RestaurantID: restaurantID}
+	specials := []*domain.SpecialHours{{RestaurantID: restaurantID, Date: "2024-12-31", Closed: true}}
+
+	// Mock
+	mockRepo := new(MockSpecialHoursLister)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID, Address: &domain.Address{State: "SP"}}, nil)
+	mockRepo.On("ListSpecialHours", ctx, restaurantID, "2024-12-30", "2025-12-30").Return(specials, nil)
+
+	// Execute
+	uc := NewListSpecialHoursUseCase(mockRepo)
+	uc.now = func() time.Time { return time.Date(2024, time.December, 31, 1, 0, 0, 0, time.UTC) }
+	result, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, specials, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestListSpecialHoursUseCase_Execute_RangeTooLong(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := ListSpecialHoursInput{RestaurantID: restaurantID, From: "2024-01-01", To: "2025-06-01"}
+
+	// Mock
+	mockRepo := new(MockSpecialHoursLister)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID}, nil)
+
+	// Execute
+	uc := NewListSpecialHoursUseCase(mockRepo)
+	result, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, domain.NewValidationError("invalid_date_range", ""))
+	mockRepo.AssertNotCalled(t, "ListSpecialHours", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestListSpecialHoursUseCase_Execute_ToBeforeFrom(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := ListSpecialHoursInput{RestaurantID: restaurantID, From: "2024-12-31", To: "2024-12-01"}
+
+	// Mock
+	mockRepo := new(MockSpecialHoursLister)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID}, nil)
+
+	// Execute
+	uc := NewListSpecialHoursUseCase(mockRepo)
+	result, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, domain.NewValidationError("invalid_date_range", ""))
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"gastro-go/internal/domain"
+)
+
+// StatusHistoryLister define a interface mínima necessária para listar o histórico de status
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
+type StatusHistoryLister interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error)
+	ListStatusHistory(ctx context.Context, restaurantID uuid.UUID, limit, offset int32) ([]*domain.StatusChange, error)
+	CountStatusHistory(ctx context.Context, restaurantID uuid.UUID) (int64, error)
+}
+
+// ListStatusHistoryUseCase implementa o caso de uso de consultar a linha do tempo de status
+type ListStatusHistoryUseCase struct {
+	repo StatusHistoryLister
+}
+
+// NewListStatusHistoryUseCase cria uma nova instância do use case
+func NewListStatusHistoryUseCase(repo StatusHistoryLister) *ListStatusHistoryUseCase {
+	return &ListStatusHistoryUseCase{
+		repo: repo,
+	}
+}
+
+// ListStatusHistoryInput representa os dados de entrada para listar o histórico
+type ListStatusHistoryInput struct {
+	RestaurantID uuid.UUID
+	Limit        int32
+	Offset       int32
+}
+
+// ListStatusHistoryOutput representa uma página do histórico de status
+type ListStatusHistoryOutput struct {
+	Items  []*domain.StatusChange
+	Limit  int32
+	Offset int32
+	Total  int64
+}
+
+// Execute executa o caso de uso de listar histórico de status
+func (uc *ListStatusHistoryUseCase) Execute(ctx context.Context, input ListStatusHistoryInput) (*ListStatusHistoryOutput, error) {
+	if input.Limit <= 0 {
+		input.Limit = 20 // Default
+	}
+	if input.Limit > 100 {
+		input.Limit = 100
+	}
+	if input.Offset < 0 {
+		input.Offset = 0
+	}
+
+	// Verificar se o restaurante existe
+	if _, err := uc.repo.GetByID(ctx, input.RestaurantID); err != nil {
+		return nil, fmt.Errorf("list status history usecase: %w", err)
+	}
+
+	items, err := uc.repo.ListStatusHistory(ctx, input.RestaurantID, input.Limit, input.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("list status history usecase: %w", err)
+	}
+
+	total, err := uc.repo.CountStatusHistory(ctx, input.RestaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("list status history usecase: %w", err)
+	}
+
+	return &ListStatusHistoryOutput{
+		Items:  items,
+		Limit:  input.Limit,
+		Offset: input.Offset,
+		Total:  total,
+	}, nil
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockStatusHistoryLister é um mock específico para StatusHistoryLister
+type MockStatusHistoryLister struct {
+	mock.Mock
+}
+
+func (m *MockStatusHistoryLister) GetByID(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Restaurant), args.Error(1)
+}
+
+func (m *MockStatusHistoryLister) ListStatusHistory(ctx context.Context, restaurantID uuid.UUID, limit, offset int32) ([]*domain.StatusChange, error) {
+	args := m.Called(ctx, restaurantID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.StatusChange), args.Error(1)
+}
+
+func (m *MockStatusHistoryLister) CountStatusHistory(ctx context.Context, restaurantID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, restaurantID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestListStatusHistoryUseCase_Execute_ClampsLimit(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := ListStatusHistoryInput{
+		RestaurantID: restaurantID,
+		Limit:        500,
+		Offset:       -1,
+	}
+	changes := []*domain.StatusChange{
+		{RestaurantID: restaurantID, FromStatus: domain.StatusDraft, ToStatus: domain.StatusOpen, Event: domain.EventOpen},
+	}
+
+	// Mock
+	mockRepo := new(MockStatusHistoryLister)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID}, nil)
+	mockRepo.On("ListStatusHistory", ctx, restaurantID, int32(100), int32(0)).Return(changes, nil)
+	mockRepo.On("CountStatusHistory", ctx, restaurantID).Return(int64(1), nil)
+
+	// Execute
+	uc := NewListStatusHistoryUseCase(mockRepo)
+	output, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int32(100), output.Limit)
+	assert.Equal(t, int32(0), output.Offset)
+	assert.Equal(t, int64(1), output.Total)
+	assert.Len(t, output.Items, 1)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestListStatusHistoryUseCase_Execute_RestaurantNotFound(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := ListStatusHistoryInput{RestaurantID: restaurantID}
+
+	// Mock
+	mockRepo := new(MockStatusHistoryLister)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(nil, domain.ErrRestaurantNotFound)
+
+	// Execute
+	uc := NewListStatusHistoryUseCase(mockRepo)
+	output, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	mockRepo.AssertNotCalled(t, "ListStatusHistory", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -12,58 +12,56 @@ import (
 // RestaurantOpener define a interface mínima necessária para abrir restaurantes
 // Segue Interface Segregation Principle: apenas os métodos que este use case precisa
 type RestaurantOpener interface {
-	GetByID(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error)
-	GetOpeningHours(ctx context.Context, restaurantID uuid.UUID) ([]*domain.OpeningHour, error)
-	GetPaymentMethods(ctx context.Context, restaurantID uuid.UUID) ([]*domain.PaymentMethod, error)
-	UpdateStatus(ctx context.Context, id uuid.UUID, status string) error
+	GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error)
+	UpdateStatus(ctx context.Context, id uuid.UUID, fromStatus, status, reason string) error
+	RecordStatusChange(ctx context.Context, change *domain.StatusChange) error
 }
 
 // OpenRestaurantUseCase implementa o caso de uso de abrir um restaurante
 type OpenRestaurantUseCase struct {
 	repo RestaurantOpener
+	tx   TxRunner
 }
 
 // NewOpenRestaurantUseCase cria uma nova instância do use case
-func NewOpenRestaurantUseCase(repo RestaurantOpener) *OpenRestaurantUseCase {
+func NewOpenRestaurantUseCase(repo RestaurantOpener, tx TxRunner) *OpenRestaurantUseCase {
 	return &OpenRestaurantUseCase{
 		repo: repo,
+		tx:   tx,
 	}
 }
 
-// Execute executa o caso de uso de abrir restaurante
-func (uc *OpenRestaurantUseCase) Execute(ctx context.Context, id uuid.UUID) error {
-	// Buscar restaurante
-	restaurant, err := uc.repo.GetByID(ctx, id)
-	if err != nil {
-		return fmt.Errorf("open restaurant usecase: %w", err)
-	}
+// OpenRestaurantInput representa os dados de entrada para abrir um restaurante
+type OpenRestaurantInput struct {
+	RestaurantID uuid.UUID
+	Actor        domain.Actor
+}
 
-	// Validações de regras de negócio
-	if restaurant.Address == nil {
-		return fmt.Errorf("open restaurant usecase: restaurant must have an address to be opened")
-	}
+// Execute executa o caso de uso de abrir restaurante
+func (uc *OpenRestaurantUseCase) Execute(ctx context.Context, input OpenRestaurantInput) error {
+	// Leitura, transição e gravação acontecem na mesma transação, com a linha travada,
+	// para que o from_status registrado no histórico seja o status efetivamente substituído
+	err := uc.tx.RunInTx(ctx, func(ctx context.Context) error {
+		// Buscar restaurante travado (carregado com endereço, horários e métodos de pagamento)
+		restaurant, err := uc.repo.GetByIDForUpdate(ctx, input.RestaurantID)
+		if err != nil {
+			return err
+		}
 
-	openingHours, err := uc.repo.GetOpeningHours(ctx, id)
-	if err != nil {
-		return fmt.Errorf("open restaurant usecase: get opening hours: %w", err)
-	}
-	if len(openingHours) == 0 {
-		return fmt.Errorf("open restaurant usecase: restaurant must have opening hours to be opened")
-	}
+		// A máquina de estados valida status de origem e pré-requisitos
+		change, err := restaurant.Transition(domain.EventOpen, input.Actor, "")
+		if err != nil {
+			return err
+		}
 
-	paymentMethods, err := uc.repo.GetPaymentMethods(ctx, id)
+		if err := uc.repo.UpdateStatus(ctx, restaurant.ID, change.FromStatus, restaurant.Status, restaurant.StatusReason); err != nil {
+			return err
+		}
+		return uc.repo.RecordStatusChange(ctx, change)
+	})
 	if err != nil {
-		return fmt.Errorf("open restaurant usecase: get payment methods: %w", err)
-	}
-	if len(paymentMethods) == 0 {
-		return fmt.Errorf("open restaurant usecase: restaurant must have at least one payment method to be opened")
-	}
-
-	// Atualizar status
-	if err := uc.repo.UpdateStatus(ctx, id, domain.StatusOpen); err != nil {
 		return fmt.Errorf("open restaurant usecase: %w", err)
 	}
 
 	return nil
 }
-
@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockRestaurantOpener é um mock específico para RestaurantOpener
+type MockRestaurantOpener struct {
+	mock.Mock
+}
+
+func (m *MockRestaurantOpener) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Restaurant), args.Error(1)
+}
+
+func (m *MockRestaurantOpener) UpdateStatus(ctx context.Context, id uuid.UUID, fromStatus, status, reason string) error {
+	args := m.Called(ctx, id, fromStatus, status, reason)
+	return args.Error(0)
+}
+
+func (m *MockRestaurantOpener) RecordStatusChange(ctx context.Context, change *domain.StatusChange) error {
+	args := m.Called(ctx, change)
+	return args.Error(0)
+}
+
+// openableRestaurant retorna um restaurante DRAFT com todos os pré-requisitos para abrir
+func openableRestaurant(id uuid.UUID) *domain.Restaurant {
+	lat, lng := -23.5614, -46.6559
+	return &domain.Restaurant{
+		ID:             id,
+		Status:         domain.StatusDraft,
+		Address:        &domain.Address{Street: "Av. Paulista", Number: "1000", City: "São Paulo", State: "SP", ZipCode: "01310100", Lat: &lat, Lng: &lng},
+		OpeningHours:   []domain.OpeningHour{{Weekday: 1, OpensAt: 660, ClosesAt: 1380}},
+		PaymentMethods: []domain.PaymentMethod{{Method: domain.PaymentMethodPIX}},
+	}
+}
+
+func TestOpenRestaurantUseCase_Execute_Success(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := OpenRestaurantInput{RestaurantID: restaurantID, Actor: domain.Actor{ID: "owner", Role: domain.RoleMerchant}}
+
+	// Mock
+	mockRepo := new(MockRestaurantOpener)
+	mockRepo.On("GetByIDForUpdate", ctx, restaurantID).Return(openableRestaurant(restaurantID), nil)
+	mockRepo.On("UpdateStatus", ctx, restaurantID, domain.StatusDraft, domain.StatusOpen, "").Return(nil)
+	mockRepo.On("RecordStatusChange", ctx, mock.MatchedBy(func(change *domain.StatusChange) bool {
+		return change.FromStatus == domain.StatusDraft &&
+			change.ToStatus == domain.StatusOpen &&
+			change.Event == domain.EventOpen &&
+			change.Actor.ID == "owner"
+	})).Return(nil)
+
+	// Execute
+	uc := NewOpenRestaurantUseCase(mockRepo, fakeTxRunner{})
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestOpenRestaurantUseCase_Execute_MissingPaymentMethod(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	restaurant := openableRestaurant(restaurantID)
+	restaurant.PaymentMethods = nil
+	input := OpenRestaurantInput{RestaurantID: restaurantID, Actor: domain.Actor{ID: "owner", Role: domain.RoleMerchant}}
+
+	// Mock
+	mockRepo := new(MockRestaurantOpener)
+	mockRepo.On("GetByIDForUpdate", ctx, restaurantID).Return(restaurant, nil)
+
+	// Execute
+	uc := NewOpenRestaurantUseCase(mockRepo, fakeTxRunner{})
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	var domainErr *domain.Error
+	assert.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "payment_method_required", domainErr.Code)
+	mockRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestOpenRestaurantUseCase_Execute_StatusChangedConcurrently(t *testing.T) {
+	// Input: um admin suspendeu o restaurante entre a leitura e a gravação
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := OpenRestaurantInput{RestaurantID: restaurantID, Actor: domain.Actor{ID: "owner", Role: domain.RoleMerchant}}
+
+	// Mock
+	mockRepo := new(MockRestaurantOpener)
+	mockRepo.On("GetByIDForUpdate", ctx, restaurantID).Return(openableRestaurant(restaurantID), nil)
+	mockRepo.On("UpdateStatus", ctx, restaurantID, domain.StatusDraft, domain.StatusOpen, "").Return(domain.ErrStatusChanged)
+
+	// Execute
+	uc := NewOpenRestaurantUseCase(mockRepo, fakeTxRunner{})
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrStatusChanged)
+	assert.ErrorIs(t, err, domain.ErrConflict)
+	mockRepo.AssertNotCalled(t, "RecordStatusChange", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"gastro-go/internal/domain"
+)
+
+// RestaurantReinstater define a interface mínima necessária para reativar restaurantes suspensos
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
+type RestaurantReinstater interface {
+	GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error)
+	UpdateStatus(ctx context.Context, id uuid.UUID, fromStatus, status, reason string) error
+	RecordStatusChange(ctx context.Context, change *domain.StatusChange) error
+}
+
+// ReinstateRestaurantUseCase implementa o caso de uso de reativar um restaurante suspenso (somente admin)
+// O restaurante volta como CLOSED; o lojista decide quando reabrir
+type ReinstateRestaurantUseCase struct {
+	repo RestaurantReinstater
+	tx   TxRunner
+}
+
+// NewReinstateRestaurantUseCase cria uma nova instância do use case
+func NewReinstateRestaurantUseCase(repo RestaurantReinstater, tx TxRunner) *ReinstateRestaurantUseCase {
+	return &ReinstateRestaurantUseCase{
+		repo: repo,
+		tx:   tx,
+	}
+}
+
+// ReinstateRestaurantInput representa os dados de entrada para reativar um restaurante
+type ReinstateRestaurantInput struct {
+	RestaurantID uuid.UUID
+	Actor        domain.Actor
+	Reason       string // Obrigatório
+}
+
+// Execute executa o caso de uso de reativar restaurante
+func (uc *ReinstateRestaurantUseCase) Execute(ctx context.Context, input ReinstateRestaurantInput) error {
+	// Leitura, transição e gravação acontecem na mesma transação, com a linha travada,
+	// para que o from_status registrado no histórico seja o status efetivamente substituído
+	err := uc.tx.RunInTx(ctx, func(ctx context.Context) error {
+		restaurant, err := uc.repo.GetByIDForUpdate(ctx, input.RestaurantID)
+		if err != nil {
+			return err
+		}
+
+		change, err := restaurant.Transition(domain.EventReinstate, input.Actor, input.Reason)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.repo.UpdateStatus(ctx, restaurant.ID, change.FromStatus, restaurant.Status, restaurant.StatusReason); err != nil {
+			return err
+		}
+		return uc.repo.RecordStatusChange(ctx, change)
+	})
+	if err != nil {
+		return fmt.Errorf("reinstate restaurant usecase: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockRestaurantReinstater é um mock específico para RestaurantReinstater
+type MockRestaurantReinstater struct {
+	mock.Mock
+}
+
+func (m *MockRestaurantReinstater) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Restaurant), args.Error(1)
+}
+
+func (m *MockRestaurantReinstater) UpdateStatus(ctx context.Context, id uuid.UUID, fromStatus, status, reason string) error {
+	args := m.Called(ctx, id, fromStatus, status, reason)
+	return args.Error(0)
+}
+
+func (m *MockRestaurantReinstater) RecordStatusChange(ctx context.Context, change *domain.StatusChange) error {
+	args := m.Called(ctx, change)
+	return args.Error(0)
+}
+
+func TestReinstateRestaurantUseCase_Execute_Success(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := ReinstateRestaurantInput{
+		RestaurantID: restaurantID,
+		Actor:        domain.Actor{ID: "ops", Role: domain.RoleAdmin},
+		Reason:       "documentação regularizada",
+	}
+
+	// Mock
+	mockRepo := new(MockRestaurantReinstater)
+	mockRepo.On("GetByIDForUpdate", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID, Status: domain.StatusSuspended}, nil)
+	mockRepo.On("UpdateStatus", ctx, restaurantID, domain.StatusSuspended, domain.StatusClosed, "documentação regularizada").Return(nil)
+	mockRepo.On("RecordStatusChange", ctx, mock.MatchedBy(func(change *domain.StatusChange) bool {
+		return change.FromStatus == domain.StatusSuspended &&
+			change.ToStatus == domain.StatusClosed &&
+			change.Event == domain.EventReinstate &&
+			change.Actor.ID == "ops"
+	})).Return(nil)
+
+	// Execute
+	uc := NewReinstateRestaurantUseCase(mockRepo, fakeTxRunner{})
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestReinstateRestaurantUseCase_Execute_MerchantForbidden(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := ReinstateRestaurantInput{
+		RestaurantID: restaurantID,
+		Actor:        domain.Actor{ID: "owner", Role: domain.RoleMerchant},
+		Reason:       "já resolvi",
+	}
+
+	// Mock
+	mockRepo := new(MockRestaurantReinstater)
+	mockRepo.On("GetByIDForUpdate", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID, Status: domain.StatusSuspended}, nil)
+
+	// Execute
+	uc := NewReinstateRestaurantUseCase(mockRepo, fakeTxRunner{})
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	mockRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"gastro-go/internal/domain"
+)
+
+// RestaurantAddressSaver define a interface mínima necessária para gravar o endereço de restaurantes
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
+type RestaurantAddressSaver interface {
+	GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error)
+	CreateAddress(ctx context.Context, address *domain.Address) error
+	UpdateAddress(ctx context.Context, address *domain.Address) error
+}
+
+// SaveRestaurantAddressUseCase implementa o caso de uso de criar ou substituir o endereço de um restaurante
+type SaveRestaurantAddressUseCase struct {
+	repo RestaurantAddressSaver
+	tx   TxRunner
+}
+
+// NewSaveRestaurantAddressUseCase cria uma nova instância do use case
+func NewSaveRestaurantAddressUseCase(repo RestaurantAddressSaver, tx TxRunner) *SaveRestaurantAddressUseCase {
+	return &SaveRestaurantAddressUseCase{
+		repo: repo,
+		tx:   tx,
+	}
+}
+
+// SaveRestaurantAddressInput representa os dados de entrada para gravar o endereço
+type SaveRestaurantAddressInput struct {
+	RestaurantID uuid.UUID
+	Address      CreateAddressInput
+}
+
+// SaveRestaurantAddressOutput representa o resultado da gravação do endereço
+type SaveRestaurantAddressOutput struct {
+	Address *domain.Address
+	Created bool // true se o restaurante ainda não tinha endereço
+}
+
+// Execute executa o caso de uso de gravar endereço
+func (uc *SaveRestaurantAddressUseCase) Execute(ctx context.Context, input SaveRestaurantAddressInput) (*SaveRestaurantAddressOutput, error) {
+	var output *SaveRestaurantAddressOutput
+
+	// Leitura e gravação acontecem na mesma transação, com a linha do restaurante travada:
+	// dois PUTs simultâneos não tentam criar o endereço duas vezes e a regra R3 é validada
+	// contra o status que vale no momento da gravação (não concorre com a abertura)
+	err := uc.tx.RunInTx(ctx, func(ctx context.Context) error {
+		restaurant, err := uc.repo.GetByIDForUpdate(ctx, input.RestaurantID)
+		if err != nil {
+			return err
+		}
+
+		address := input.Address.toDomain(restaurant.ID)
+
+		// R3: coordenadas obrigatórias apenas para restaurantes OPEN
+		if err := address.Validate(restaurant.Status == domain.StatusOpen); err != nil {
+			return err
+		}
+
+		if restaurant.Address == nil {
+			if err := uc.repo.CreateAddress(ctx, address); err != nil {
+				return err
+			}
+			output = &SaveRestaurantAddressOutput{Address: address, Created: true}
+			return nil
+		}
+
+		address.ID = restaurant.Address.ID
+		if err := uc.repo.UpdateAddress(ctx, address); err != nil {
+			return err
+		}
+		output = &SaveRestaurantAddressOutput{Address: address, Created: false}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("save restaurant address usecase: %w", err)
+	}
+
+	return output, nil
+}
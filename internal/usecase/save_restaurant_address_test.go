@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockRestaurantAddressSaver é um mock específico para RestaurantAddressSaver
+type MockRestaurantAddressSaver struct {
+	mock.Mock
+}
+
+func (m *MockRestaurantAddressSaver) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Restaurant), args.Error(1)
+}
+
+func (m *MockRestaurantAddressSaver) CreateAddress(ctx context.Context, address *domain.Address) error {
+	args := m.Called(ctx, address)
+	return args.Error(0)
+}
+
+func (m *MockRestaurantAddressSaver) UpdateAddress(ctx context.Context, address *domain.Address) error {
+	args := m.Called(ctx, address)
+	return args.Error(0)
+}
+
+func TestSaveRestaurantAddressUseCase_Execute_CreatesForDraftWithoutCoordinates(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := SaveRestaurantAddressInput{
+		RestaurantID: restaurantID,
+		Address: CreateAddressInput{
+			Street:  "Rua Augusta",
+			Number:  "500",
+			City:    "São Paulo",
+			State:   "SP",
+			ZipCode: "01305-000",
+		},
+	}
+
+	// Mock
+	mockRepo := new(MockRestaurantAddressSaver)
+	mockRepo.On("GetByIDForUpdate", mock.Anything, restaurantID).Return(&domain.Restaurant{ID: restaurantID, Status: domain.StatusDraft}, nil)
+	mockRepo.On("CreateAddress", mock.Anything, mock.AnythingOfType("*domain.Address")).Return(nil)
+
+	// Execute
+	uc := NewSaveRestaurantAddressUseCase(mockRepo, fakeTxRunner{})
+	output, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, output.Created)
+	assert.Equal(t, "01305000", output.Address.ZipCode)
+	assert.Nil(t, output.Address.Lat)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSaveRestaurantAddressUseCase_Execute_OpenRestaurantRequiresCoordinates(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := SaveRestaurantAddressInput{
+		RestaurantID: restaurantID,
+		Address: CreateAddressInput{
+			Street:  "Rua Augusta",
+			Number:  "500",
+			City:    "São Paulo",
+			State:   "SP",
+			ZipCode: "01305000",
+		},
+	}
+
+	// Mock
+	lat, lng := -23.55, -46.65
+	existing := &domain.Address{ID: uuid.New(), RestaurantID: restaurantID, Lat: &lat, Lng: &lng}
+	mockRepo := new(MockRestaurantAddressSaver)
+	mockRepo.On("GetByIDForUpdate", mock.Anything, restaurantID).Return(&domain.Restaurant{ID: restaurantID, Status: domain.StatusOpen, Address: existing}, nil)
+
+	// Execute
+	uc := NewSaveRestaurantAddressUseCase(mockRepo, fakeTxRunner{})
+	output, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	assert.Contains(t, err.Error(), "lat and lng are required")
+	mockRepo.AssertNotCalled(t, "UpdateAddress", mock.Anything, mock.Anything)
+}
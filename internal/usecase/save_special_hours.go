@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"gastro-go/internal/domain"
+)
+
+// SpecialHoursSaver define a interface mínima necessária para salvar exceções de horário
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
+type SpecialHoursSaver interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error)
+	DeleteSpecialHours(ctx context.Context, restaurantID uuid.UUID, date string) (int64, error)
+	CreateSpecialHours(ctx context.Context, special *domain.SpecialHours) error
+}
+
+// SaveSpecialHoursUseCase implementa o caso de uso de criar ou substituir a exceção de uma data
+type SaveSpecialHoursUseCase struct {
+	repo SpecialHoursSaver
+	tx   TxRunner
+}
+
+// NewSaveSpecialHoursUseCase cria uma nova instância do use case
+func NewSaveSpecialHoursUseCase(repo SpecialHoursSaver, tx TxRunner) *SaveSpecialHoursUseCase {
+	return &SaveSpecialHoursUseCase{
+		repo: repo,
+		tx:   tx,
+	}
+}
+
+// SaveSpecialHoursInput representa os dados de entrada para salvar uma exceção
+type SaveSpecialHoursInput struct {
+	RestaurantID uuid.UUID
+	Date         string // "2006-01-02"
+	Closed       bool   // Fechado o dia todo
+	Intervals    []domain.TimeInterval
+	Note         string
+}
+
+// Execute executa o caso de uso de salvar exceção de horário
+func (uc *SaveSpecialHoursUseCase) Execute(ctx context.Context, input SaveSpecialHoursInput) (*domain.SpecialHours, error) {
+	special := &domain.SpecialHours{
+		RestaurantID: input.RestaurantID,
+		Date:         input.Date,
+		Closed:       input.Closed,
+		Intervals:    input.Intervals,
+		Note:         input.Note,
+	}
+	if special.Intervals == nil {
+		special.Intervals = []domain.TimeInterval{}
+	}
+
+	if err := special.Validate(); err != nil {
+		return nil, fmt.Errorf("save special hours usecase: %w", err)
+	}
+
+	// Verificar se o restaurante existe
+	if _, err := uc.repo.GetByID(ctx, input.RestaurantID); err != nil {
+		return nil, fmt.Errorf("save special hours usecase: %w", err)
+	}
+
+	// Substituir a exceção da data atomicamente
+	err := uc.tx.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.repo.DeleteSpecialHours(ctx, input.RestaurantID, input.Date); err != nil {
+			return fmt.Errorf("save special hours usecase: delete existing: %w", err)
+		}
+		if err := uc.repo.CreateSpecialHours(ctx, special); err != nil {
+			return fmt.Errorf("save special hours usecase: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return special, nil
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockSpecialHoursSaver é um mock específico para SpecialHoursSaver
+type MockSpecialHoursSaver struct {
+	mock.Mock
+}
+
+func (m *MockSpecialHoursSaver) GetByID(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Restaurant), args.Error(1)
+}
+
+func (m *MockSpecialHoursSaver) DeleteSpecialHours(ctx context.Context, restaurantID uuid.UUID, date string) (int64, error) {
+	args := m.Called(ctx, restaurantID, date)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockSpecialHoursSaver) CreateSpecialHours(ctx context.Context, special *domain.SpecialHours) error {
+	args := m.Called(ctx, special)
+	return args.Error(0)
+}
+
+func TestSaveSpecialHoursUseCase_Execute_ReplacesDate(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := SaveSpecialHoursInput{
+		RestaurantID: restaurantID,
+		Date:         "2024-12-31",
+		Intervals:    []domain.TimeInterval{{OpensAt: 18 * 60, ClosesAt: 23 * 60}},
+		Note:         "Réveillon",
+	}
+
+	// Mock
+	mockRepo := new(MockSpecialHoursSaver)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID}, nil)
+	mockRepo.On("DeleteSpecialHours", ctx, restaurantID, "2024-12-31").Return(int64(1), nil)
+	mockRepo.On("CreateSpecialHours", ctx, mock.MatchedBy(func(special *domain.SpecialHours) bool {
+		return special.Date == "2024-12-31" && !special.Closed && len(special.Intervals) == 1
+	})).Return(nil)
+
+	// Execute
+	uc := NewSaveSpecialHoursUseCase(mockRepo, fakeTxRunner{})
+	special, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Réveillon", special.Note)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSaveSpecialHoursUseCase_Execute_InvalidDate(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := SaveSpecialHoursInput{
+		RestaurantID: uuid.New(),
+		Date:         "2024-02-30",
+		Closed:       true,
+	}
+
+	// Mock
+	mockRepo := new(MockSpecialHoursSaver)
+
+	// Execute
+	uc := NewSaveSpecialHoursUseCase(mockRepo, fakeTxRunner{})
+	special, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, special)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	mockRepo.AssertNotCalled(t, "CreateSpecialHours", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"gastro-go/internal/domain"
+)
+
+// RestaurantSuspender define a interface mínima necessária para suspender restaurantes
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
+type RestaurantSuspender interface {
+	GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error)
+	UpdateStatus(ctx context.Context, id uuid.UUID, fromStatus, status, reason string) error
+	RecordStatusChange(ctx context.Context, change *domain.StatusChange) error
+}
+
+// SuspendRestaurantUseCase implementa o caso de uso de suspender um restaurante (somente admin)
+type SuspendRestaurantUseCase struct {
+	repo RestaurantSuspender
+	tx   TxRunner
+}
+
+// NewSuspendRestaurantUseCase cria uma nova instância do use case
+func NewSuspendRestaurantUseCase(repo RestaurantSuspender, tx TxRunner) *SuspendRestaurantUseCase {
+	return &SuspendRestaurantUseCase{
+		repo: repo,
+		tx:   tx,
+	}
+}
+
+// SuspendRestaurantInput representa os dados de entrada para suspender um restaurante
+type SuspendRestaurantInput struct {
+	RestaurantID uuid.UUID
+	Actor        domain.Actor
+	Reason       string // Obrigatório
+}
+
+// Execute executa o caso de uso de suspender restaurante
+func (uc *SuspendRestaurantUseCase) Execute(ctx context.Context, input SuspendRestaurantInput) error {
+	// Leitura, transição e gravação acontecem na mesma transação, com a linha travada,
+	// para que o from_status registrado no histórico seja o status efetivamente substituído
+	err := uc.tx.RunInTx(ctx, func(ctx context.Context) error {
+		restaurant, err := uc.repo.GetByIDForUpdate(ctx, input.RestaurantID)
+		if err != nil {
+			return err
+		}
+
+		change, err := restaurant.Transition(domain.EventSuspend, input.Actor, input.Reason)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.repo.UpdateStatus(ctx, restaurant.ID, change.FromStatus, restaurant.Status, restaurant.StatusReason); err != nil {
+			return err
+		}
+		return uc.repo.RecordStatusChange(ctx, change)
+	})
+	if err != nil {
+		return fmt.Errorf("suspend restaurant usecase: %w", err)
+	}
+
+	return nil
+}
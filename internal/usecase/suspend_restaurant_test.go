@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockRestaurantSuspender é um mock específico para RestaurantSuspender
+type MockRestaurantSuspender struct {
+	mock.Mock
+}
+
+func (m *MockRestaurantSuspender) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Restaurant), args.Error(1)
+}
+
+func (m *MockRestaurantSuspender) UpdateStatus(ctx context.Context, id uuid.UUID, fromStatus, status, reason string) error {
+	args := m.Called(ctx, id, fromStatus, status, reason)
+	return args.Error(0)
+}
+
+func (m *MockRestaurantSuspender) RecordStatusChange(ctx context.Context, change *domain.StatusChange) error {
+	args := m.Called(ctx, change)
+	return args.Error(0)
+}
+
+func TestSuspendRestaurantUseCase_Execute_Success(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := SuspendRestaurantInput{
+		RestaurantID: restaurantID,
+		Actor:        domain.Actor{ID: "ops", Role: domain.RoleAdmin},
+		Reason:       "documentação pendente",
+	}
+
+	// Mock
+	mockRepo := new(MockRestaurantSuspender)
+	mockRepo.On("GetByIDForUpdate", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID, Status: domain.StatusOpen}, nil)
+	mockRepo.On("UpdateStatus", ctx, restaurantID, domain.StatusOpen, domain.StatusSuspended, "documentação pendente").Return(nil)
+	mockRepo.On("RecordStatusChange", ctx, mock.MatchedBy(func(change *domain.StatusChange) bool {
+		return change.FromStatus == domain.StatusOpen &&
+			change.ToStatus == domain.StatusSuspended &&
+			change.Event == domain.EventSuspend &&
+			change.Actor.ID == "ops"
+	})).Return(nil)
+
+	// Execute
+	uc := NewSuspendRestaurantUseCase(mockRepo, fakeTxRunner{})
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSuspendRestaurantUseCase_Execute_MerchantForbidden(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := SuspendRestaurantInput{
+		RestaurantID: restaurantID,
+		Actor:        domain.Actor{ID: "owner", Role: domain.RoleMerchant},
+		Reason:       "quero pausar",
+	}
+
+	// Mock
+	mockRepo := new(MockRestaurantSuspender)
+	mockRepo.On("GetByIDForUpdate", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID, Status: domain.StatusOpen}, nil)
+
+	// Execute
+	uc := NewSuspendRestaurantUseCase(mockRepo, fakeTxRunner{})
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	mockRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "RecordStatusChange", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,9 @@
+package usecase
+
+import "context"
+
+// TxRunner define a interface mínima para executar escritas de forma atômica
+// Repositórios chamados com o ctx recebido por fn participam da mesma transação
+type TxRunner interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,11 @@
+package usecase
+
+import "context"
+
+// fakeTxRunner executa a função diretamente, sem transação real
+// Usado nos testes de use cases que dependem de TxRunner
+type fakeTxRunner struct{}
+
+func (fakeTxRunner) RunInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"gastro-go/internal/domain"
+)
+
+// CategoryUpdater define a interface mínima necessária para atualizar categorias
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
+type CategoryUpdater interface {
+	GetCategory(ctx context.Context, id uuid.UUID) (*domain.Category, error)
+	CategorySlugExists(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error)
+	UpdateCategory(ctx context.Context, category *domain.Category) error
+	SyncPrimaryCategoryName(ctx context.Context, id uuid.UUID) error
+}
+
+// UpdateCategoryUseCase implementa o caso de uso de atualização de categoria (somente admin)
+type UpdateCategoryUseCase struct {
+	repo CategoryUpdater
+	tx   TxRunner
+}
+
+// NewUpdateCategoryUseCase cria uma nova instância do use case
+func NewUpdateCategoryUseCase(repo CategoryUpdater, tx TxRunner) *UpdateCategoryUseCase {
+	return &UpdateCategoryUseCase{
+		repo: repo,
+		tx:   tx,
+	}
+}
+
+// UpdateCategoryInput representa os dados de entrada para atualizar uma categoria
+type UpdateCategoryInput struct {
+	ID       uuid.UUID
+	Actor    domain.Actor
+	Name     string
+	Slug     string // Vazio mantém o slug atual
+	Icon     string
+	Position int
+}
+
+// Execute executa o caso de uso de atualização de categoria
+// O novo nome é propagado para os restaurantes em que a categoria é a principal
+func (uc *UpdateCategoryUseCase) Execute(ctx context.Context, input UpdateCategoryInput) (*domain.Category, error) {
+	if err := domain.CanManageCategories(input.Actor); err != nil {
+		return nil, fmt.Errorf("update category usecase: %w", err)
+	}
+
+	category, err := uc.repo.GetCategory(ctx, input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("update category usecase: %w", err)
+	}
+
+	category.Name = input.Name
+	category.Icon = input.Icon
+	category.Position = input.Position
+	if input.Slug != "" {
+		category.Slug = input.Slug
+	}
+	if err := category.Validate(); err != nil {
+		return nil, fmt.Errorf("update category usecase: %w", err)
+	}
+
+	exists, err := uc.repo.CategorySlugExists(ctx, category.Slug, category.ID)
+	if err != nil {
+		return nil, fmt.Errorf("update category usecase: check slug uniqueness: %w", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("update category usecase: %w", domain.ErrCategorySlugAlreadyExists)
+	}
+
+	err = uc.tx.RunInTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.UpdateCategory(ctx, category); err != nil {
+			return err
+		}
+		return uc.repo.SyncPrimaryCategoryName(ctx, category.ID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update category usecase: %w", err)
+	}
+
+	return category, nil
+}
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockCategoryUpdater é um mock específico para CategoryUpdater
+type MockCategoryUpdater struct {
+	mock.Mock
+}
+
+func (m *MockCategoryUpdater) GetCategory(ctx context.Context, id uuid.UUID) (*domain.Category, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Category), args.Error(1)
+}
+
+func (m *MockCategoryUpdater) CategorySlugExists(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, slug, excludeID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockCategoryUpdater) UpdateCategory(ctx context.Context, category *domain.Category) error {
+	args := m.Called(ctx, category)
+	return args.Error(0)
+}
+
+func (m *MockCategoryUpdater) SyncPrimaryCategoryName(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func TestUpdateCategoryUseCase_Execute_Success(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	categoryID := uuid.New()
+	input := UpdateCategoryInput{
+		ID:       categoryID,
+		Actor:    domain.Actor{ID: "ops", Role: domain.RoleAdmin},
+		Name:     "Pizzaria",
+		Icon:     "pizza.svg",
+		Position: 2,
+	}
+
+	// Mock: sem slug no input, o slug atual é mantido
+	current := &domain.Category{ID: categoryID, Slug: "pizza", Name: "Pizza", Position: 1}
+	mockRepo := new(MockCategoryUpdater)
+	mockRepo.On("GetCategory", ctx, categoryID).Return(current, nil)
+	mockRepo.On("CategorySlugExists", ctx, "pizza", categoryID).Return(false, nil)
+	mockRepo.On("UpdateCategory", ctx, mock.MatchedBy(func(c *domain.Category) bool {
+		return c.Slug == "pizza" && c.Name == "Pizzaria" && c.Icon == "pizza.svg" && c.Position == 2
+	})).Return(nil)
+	mockRepo.On("SyncPrimaryCategoryName", ctx, categoryID).Return(nil)
+
+	// Execute
+	uc := NewUpdateCategoryUseCase(mockRepo, fakeTxRunner{})
+	category, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Pizzaria", category.Name)
+	assert.Equal(t, "pizza", category.Slug)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateCategoryUseCase_Execute_RequiresAdmin(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := UpdateCategoryInput{ID: uuid.New(), Actor: domain.Actor{ID: "owner", Role: domain.RoleMerchant}, Name: "Pizza"}
+
+	// Mock
+	mockRepo := new(MockCategoryUpdater)
+
+	// Execute
+	uc := NewUpdateCategoryUseCase(mockRepo, fakeTxRunner{})
+	category, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, category)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	mockRepo.AssertNotCalled(t, "GetCategory", mock.Anything, mock.Anything)
+}
+
+func TestUpdateCategoryUseCase_Execute_NotFound(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := UpdateCategoryInput{ID: uuid.New(), Actor: domain.Actor{ID: "ops", Role: domain.RoleAdmin}, Name: "Pizza"}
+
+	// Mock
+	mockRepo := new(MockCategoryUpdater)
+	mockRepo.On("GetCategory", ctx, input.ID).Return(nil, domain.ErrCategoryNotFound)
+
+	// Execute
+	uc := NewUpdateCategoryUseCase(mockRepo, fakeTxRunner{})
+	category, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, category)
+	assert.ErrorIs(t, err, domain.ErrCategoryNotFound)
+	mockRepo.AssertNotCalled(t, "UpdateCategory", mock.Anything, mock.Anything)
+}
+
+func TestUpdateCategoryUseCase_Execute_SlugConflict(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	categoryID := uuid.New()
+	input := UpdateCategoryInput{
+		ID:    categoryID,
+		Actor: domain.Actor{ID: "ops", Role: domain.RoleAdmin},
+		Name:  "Japonesa",
+		Slug:  "japonesa",
+	}
+
+	// Mock
+	current := &domain.Category{ID: categoryID, Slug: "sushi", Name: "Sushi"}
+	mockRepo := new(MockCategoryUpdater)
+	mockRepo.On("GetCategory", ctx, categoryID).Return(current, nil)
+	mockRepo.On("CategorySlugExists", ctx, "japonesa", categoryID).Return(true, nil)
+
+	// Execute
+	uc := NewUpdateCategoryUseCase(mockRepo, fakeTxRunner{})
+	category, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, category)
+	assert.ErrorIs(t, err, domain.ErrCategorySlugAlreadyExists)
+	mockRepo.AssertNotCalled(t, "UpdateCategory", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"gastro-go/internal/domain"
+)
+
+// DeliveryAreasUpdater define a interface mínima necessária para atualizar áreas de entrega
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
+type DeliveryAreasUpdater interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error)
+	DeleteDeliveryAreasByRestaurant(ctx context.Context, restaurantID uuid.UUID) error
+	CreateDeliveryArea(ctx context.Context, area *domain.DeliveryArea) error
+}
+
+// UpdateDeliveryAreasUseCase implementa o caso de uso de atualizar áreas de entrega
+type UpdateDeliveryAreasUseCase struct {
+	repo DeliveryAreasUpdater
+	tx   TxRunner
+}
+
+// NewUpdateDeliveryAreasUseCase cria uma nova instância do use case
+func NewUpdateDeliveryAreasUseCase(repo DeliveryAreasUpdater, tx TxRunner) *UpdateDeliveryAreasUseCase {
+	return &UpdateDeliveryAreasUseCase{
+		repo: repo,
+		tx:   tx,
+	}
+}
+
+// DeliveryAreaInput representa uma área de entrega
+type DeliveryAreaInput struct {
+	Kind         string                 // "RADIUS" ou "POLYGON"
+	RadiusMeters int                    // Apenas RADIUS
+	Polygon      *domain.GeoJSONPolygon // Apenas POLYGON
+}
+
+// UpdateDeliveryAreasInput representa os dados de entrada para atualizar áreas de entrega
+type UpdateDeliveryAreasInput struct {
+	RestaurantID uuid.UUID
+	Areas        []DeliveryAreaInput
+}
+
+// Execute substitui as áreas de entrega do restaurante
+func (uc *UpdateDeliveryAreasUseCase) Execute(ctx context.Context, input UpdateDeliveryAreasInput) ([]domain.DeliveryArea, error) {
+	restaurant, err := uc.repo.GetByID(ctx, input.RestaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("update delivery areas usecase: %w", err)
+	}
+
+	areas := make([]domain.DeliveryArea, 0, len(input.Areas))
+	for _, areaInput := range input.Areas {
+		areas = append(areas, domain.DeliveryArea{
+			RestaurantID: input.RestaurantID,
+			Kind:         areaInput.Kind,
+			RadiusMeters: areaInput.RadiusMeters,
+			Polygon:      areaInput.Polygon,
+		})
+	}
+	if err := domain.ValidateDeliveryAreas(areas, restaurant.Address); err != nil {
+		return nil, fmt.Errorf("update delivery areas usecase: %w", err)
+	}
+
+	// Substituir áreas atomicamente: uma falha no meio não apaga as áreas atuais
+	err = uc.tx.RunInTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.DeleteDeliveryAreasByRestaurant(ctx, input.RestaurantID); err != nil {
+			return fmt.Errorf("update delivery areas usecase: delete existing areas: %w", err)
+		}
+
+		for i := range areas {
+			if err := uc.repo.CreateDeliveryArea(ctx, &areas[i]); err != nil {
+				return fmt.Errorf("update delivery areas usecase: create area: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return areas, nil
+}
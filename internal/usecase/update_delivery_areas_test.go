@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockDeliveryAreasUpdater é um mock específico para DeliveryAreasUpdater
+type MockDeliveryAreasUpdater struct {
+	mock.Mock
+}
+
+func (m *MockDeliveryAreasUpdater) GetByID(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Restaurant), args.Error(1)
+}
+
+func (m *MockDeliveryAreasUpdater) DeleteDeliveryAreasByRestaurant(ctx context.Context, restaurantID uuid.UUID) error {
+	args := m.Called(ctx, restaurantID)
+	return args.Error(0)
+}
+
+func (m *MockDeliveryAreasUpdater) CreateDeliveryArea(ctx context.Context, area *domain.DeliveryArea) error {
+	args := m.Called(ctx, area)
+	return args.Error(0)
+}
+
+func TestUpdateDeliveryAreasUseCase_Execute_Success(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	lat, lng := -23.5614, -46.6559
+	input := UpdateDeliveryAreasInput{
+		RestaurantID: restaurantID,
+		Areas: []DeliveryAreaInput{
+			{Kind: domain.DeliveryAreaRadius, RadiusMeters: 3000},
+			{Kind: domain.DeliveryAreaPolygon, Polygon: &domain.GeoJSONPolygon{
+				Type:        "Polygon",
+				Coordinates: [][][]float64{{{-46.67, -23.57}, {-46.65, -23.57}, {-46.65, -23.55}, {-46.67, -23.57}}},
+			}},
+		},
+	}
+
+	// Mock
+	mockRepo := new(MockDeliveryAreasUpdater)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID, Address: &domain.Address{Lat: &lat, Lng: &lng}}, nil)
+	mockRepo.On("DeleteDeliveryAreasByRestaurant", ctx, restaurantID).Return(nil)
+	mockRepo.On("CreateDeliveryArea", ctx, mock.AnythingOfType("*domain.DeliveryArea")).Return(nil).Twice()
+
+	// Execute
+	uc := NewUpdateDeliveryAreasUseCase(mockRepo, fakeTxRunner{})
+	areas, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, areas, 2)
+	assert.Equal(t, restaurantID, areas[0].RestaurantID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateDeliveryAreasUseCase_Execute_RadiusRequiresCoordinates(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := UpdateDeliveryAreasInput{
+		RestaurantID: restaurantID,
+		Areas:        []DeliveryAreaInput{{Kind: domain.DeliveryAreaRadius, RadiusMeters: 3000}},
+	}
+
+	// Mock
+	mockRepo := new(MockDeliveryAreasUpdater)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID}, nil)
+
+	// Execute
+	uc := NewUpdateDeliveryAreasUseCase(mockRepo, fakeTxRunner{})
+	areas, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, areas)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	mockRepo.AssertNotCalled(t, "DeleteDeliveryAreasByRestaurant", mock.Anything, mock.Anything)
+}
+
+func TestUpdateDeliveryAreasUseCase_Execute_CreateFails(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	lat, lng := -23.5614, -46.6559
+	input := UpdateDeliveryAreasInput{
+		RestaurantID: restaurantID,
+		Areas:        []DeliveryAreaInput{{Kind: domain.DeliveryAreaRadius, RadiusMeters: 3000}},
+	}
+
+	// Mock
+	mockRepo := new(MockDeliveryAreasUpdater)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID, Address: &domain.Address{Lat: &lat, Lng: &lng}}, nil)
+	mockRepo.On("DeleteDeliveryAreasByRestaurant", ctx, restaurantID).Return(nil)
+	mockRepo.On("CreateDeliveryArea", ctx, mock.AnythingOfType("*domain.DeliveryArea")).Return(errors.New("insert failed"))
+
+	// Execute
+	uc := NewUpdateDeliveryAreasUseCase(mockRepo, fakeTxRunner{})
+	areas, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, areas)
+	assert.Error(t, err)
+}
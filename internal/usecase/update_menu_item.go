@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"gastro-go/internal/domain"
+)
+
+// MenuItemUpdater define a interface mínima necessária para atualizar itens do cardápio
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
+type MenuItemUpdater interface {
+	GetMenuSection(ctx context.Context, restaurantID, id uuid.UUID) (*domain.MenuSection, error)
+	UpdateMenuItem(ctx context.Context, item *domain.MenuItem) error
+}
+
+// UpdateMenuItemUseCase implementa o caso de uso de atualização de item do cardápio
+type UpdateMenuItemUseCase struct {
+	repo MenuItemUpdater
+}
+
+// NewUpdateMenuItemUseCase cria uma nova instância do use case
+func NewUpdateMenuItemUseCase(repo MenuItemUpdater) *UpdateMenuItemUseCase {
+	return &UpdateMenuItemUseCase{
+		repo: repo,
+	}
+}
+
+// UpdateMenuItemInput representa os dados de entrada para atualizar um item
+// SectionID diferente da atual move o item para outra seção do mesmo restaurante
+type UpdateMenuItemInput struct {
+	RestaurantID uuid.UUID
+	ItemID       uuid.UUID
+	SectionID    uuid.UUID
+	Item         MenuItemInput
+}
+
+// Execute substitui os dados do item
+func (uc *UpdateMenuItemUseCase) Execute(ctx context.Context, input UpdateMenuItemInput) (*domain.MenuItem, error) {
+	item := input.Item.toDomain(input.RestaurantID, input.SectionID)
+	item.ID = input.ItemID
+	if err := item.Validate(); err != nil {
+		return nil, fmt.Errorf("update menu item usecase: %w", err)
+	}
+
+	if _, err := uc.repo.GetMenuSection(ctx, input.RestaurantID, input.SectionID); err != nil {
+		return nil, fmt.Errorf("update menu item usecase: %w", err)
+	}
+
+	if err := uc.repo.UpdateMenuItem(ctx, item); err != nil {
+		return nil, fmt.Errorf("update menu item usecase: %w", err)
+	}
+
+	return item, nil
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"gastro-go/internal/domain"
+)
+
+// MenuItemAvailabilityUpdater define a interface mínima necessária para atualizar as janelas de venda de um item
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
+type MenuItemAvailabilityUpdater interface {
+	GetMenuItem(ctx context.Context, restaurantID, id uuid.UUID) (*domain.MenuItem, error)
+	DeleteMenuItemAvailability(ctx context.Context, restaurantID, itemID uuid.UUID) error
+	CreateMenuItemWindow(ctx context.Context, itemID uuid.UUID, window *domain.OpeningHour) error
+}
+
+// UpdateMenuItemAvailabilityUseCase implementa o caso de uso de atualizar as janelas de venda de um item
+type UpdateMenuItemAvailabilityUseCase struct {
+	repo MenuItemAvailabilityUpdater
+	tx   TxRunner
+}
+
+// NewUpdateMenuItemAvailabilityUseCase cria uma nova instância do use case
+func NewUpdateMenuItemAvailabilityUseCase(repo MenuItemAvailabilityUpdater, tx TxRunner) *UpdateMenuItemAvailabilityUseCase {
+	return &UpdateMenuItemAvailabilityUseCase{
+		repo: repo,
+		tx:   tx,
+	}
+}
+
+// UpdateMenuItemAvailabilityInput representa os dados de entrada para atualizar as janelas de um item
+// Sem janelas, o item segue apenas as janelas da sua seção
+type UpdateMenuItemAvailabilityInput struct {
+	RestaurantID uuid.UUID
+	ItemID       uuid.UUID
+	Windows      []OpeningHourInput
+}
+
+// Execute substitui as janelas de venda do item
+func (uc *UpdateMenuItemAvailabilityUseCase) Execute(ctx context.Context, input UpdateMenuItemAvailabilityInput) ([]domain.OpeningHour, error) {
+	if _, err := uc.repo.GetMenuItem(ctx, input.RestaurantID, input.ItemID); err != nil {
+		return nil, fmt.Errorf("update menu item availability usecase: %w", err)
+	}
+
+	windows, err := availabilityWindows(input.RestaurantID, input.Windows)
+	if err != nil {
+		return nil, fmt.Errorf("update menu item availability usecase: %w", err)
+	}
+
+	// Substituir janelas atomicamente: uma falha no meio não apaga as janelas atuais
+	err = uc.tx.RunInTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.DeleteMenuItemAvailability(ctx, input.RestaurantID, input.ItemID); err != nil {
+			return fmt.Errorf("update menu item availability usecase: delete existing windows: %w", err)
+		}
+
+		for i := range windows {
+			if err := uc.repo.CreateMenuItemWindow(ctx, input.ItemID, &windows[i]); err != nil {
+				return fmt.Errorf("update menu item availability usecase: create window: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return windows, nil
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockMenuItemAvailabilityUpdater é um mock específico para MenuItemAvailabilityUpdater
+type MockMenuItemAvailabilityUpdater struct {
+	mock.Mock
+}
+
+func (m *MockMenuItemAvailabilityUpdater) GetMenuItem(ctx context.Context, restaurantID, id uuid.UUID) (*domain.MenuItem, error) {
+	args := m.Called(ctx, restaurantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.MenuItem), args.Error(1)
+}
+
+func (m *MockMenuItemAvailabilityUpdater) DeleteMenuItemAvailability(ctx context.Context, restaurantID, itemID uuid.UUID) error {
+	args := m.Called(ctx, restaurantID, itemID)
+	return args.Error(0)
+}
+
+func (m *MockMenuItemAvailabilityUpdater) CreateMenuItemWindow(ctx context.Context, itemID uuid.UUID, window *domain.OpeningHour) error {
+	args := m.Called(ctx, itemID, window)
+	return args.Error(0)
+}
+
+func TestUpdateMenuItemAvailabilityUseCase_Execute_Success(t *testing.T) {
+	// Input: PF de segunda a sexta, 11:00–15:00
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	itemID := uuid.New()
+	input := UpdateMenuItemAvailabilityInput{RestaurantID: restaurantID, ItemID: itemID}
+	for weekday := 1; weekday <= 5; weekday++ {
+		input.Windows = append(input.Windows, OpeningHourInput{Weekday: weekday, OpensAt: 660, ClosesAt: 900})
+	}
+
+	// Mock
+	mockRepo := new(MockMenuItemAvailabilityUpdater)
+	mockRepo.On("GetMenuItem", ctx, restaurantID, itemID).Return(&domain.MenuItem{ID: itemID, RestaurantID: restaurantID}, nil)
+	mockRepo.On("DeleteMenuItemAvailability", mock.Anything, restaurantID, itemID).Return(nil)
+	mockRepo.On("CreateMenuItemWindow", mock.Anything, itemID, mock.AnythingOfType("*domain.OpeningHour")).Return(nil).Times(5)
+
+	// Execute
+	uc := NewUpdateMenuItemAvailabilityUseCase(mockRepo, fakeTxRunner{})
+	windows, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, windows, 5)
+	assert.Equal(t, restaurantID, windows[0].RestaurantID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateMenuItemAvailabilityUseCase_Execute_Overlap(t *testing.T) {
+	// Input: janela de sexta que atravessa a meia-noite colide com a de sábado
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	itemID := uuid.New()
+	input := UpdateMenuItemAvailabilityInput{
+		RestaurantID: restaurantID,
+		ItemID:       itemID,
+		Windows: []OpeningHourInput{
+			{Weekday: 5, OpensAt: 1320, ClosesAt: 120},
+			{Weekday: 6, OpensAt: 60, ClosesAt: 300},
+		},
+	}
+
+	// Mock
+	mockRepo := new(MockMenuItemAvailabilityUpdater)
+	mockRepo.On("GetMenuItem", ctx, restaurantID, itemID).Return(&domain.MenuItem{ID: itemID, RestaurantID: restaurantID}, nil)
+
+	// Execute
+	uc := NewUpdateMenuItemAvailabilityUseCase(mockRepo, fakeTxRunner{})
+	windows, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, windows)
+	var domainErr *domain.Error
+	assert.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "opening_hours_overlap", domainErr.Code)
+	mockRepo.AssertNotCalled(t, "DeleteMenuItemAvailability", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockMenuItemUpdater é um mock específico para MenuItemUpdater
+type MockMenuItemUpdater struct {
+	mock.Mock
+}
+
+func (m *MockMenuItemUpdater) GetMenuSection(ctx context.Context, restaurantID, id uuid.UUID) (*domain.MenuSection, error) {
+	args := m.Called(ctx, restaurantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.MenuSection), args.Error(1)
+}
+
+func (m *MockMenuItemUpdater) UpdateMenuItem(ctx context.Context, item *domain.MenuItem) error {
+	args := m.Called(ctx, item)
+	return args.Error(0)
+}
+
+func TestUpdateMenuItemUseCase_Execute_MoveToSection(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	itemID := uuid.New()
+	drinksID := uuid.New()
+	input := UpdateMenuItemInput{
+		RestaurantID: restaurantID,
+		ItemID:       itemID,
+		SectionID:    drinksID,
+		Item:         MenuItemInput{Name: "Suco de laranja", Price: 1200, Active: true},
+	}
+
+	// Mock
+	mockRepo := new(MockMenuItemUpdater)
+	mockRepo.On("GetMenuSection", ctx, restaurantID, drinksID).Return(&domain.MenuSection{ID: drinksID, RestaurantID: restaurantID}, nil)
+	mockRepo.On("UpdateMenuItem", ctx, mock.MatchedBy(func(i *domain.MenuItem) bool {
+		return i.ID == itemID && i.SectionID == drinksID && i.RestaurantID == restaurantID && i.Price == 1200
+	})).Return(nil)
+
+	// Execute
+	uc := NewUpdateMenuItemUseCase(mockRepo)
+	item, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, drinksID, item.SectionID)
+	assert.Equal(t, "Suco de laranja", item.Name)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateMenuItemUseCase_Execute_SectionOfAnotherRestaurant(t *testing.T) {
+	// Input: a seção existe, mas pertence a outro restaurante
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	foreignSectionID := uuid.New()
+	input := UpdateMenuItemInput{
+		RestaurantID: restaurantID,
+		ItemID:       uuid.New(),
+		SectionID:    foreignSectionID,
+		Item:         MenuItemInput{Name: "Suco de laranja", Price: 1200},
+	}
+
+	// Mock: a busca é sempre escopada pelo restaurante
+	mockRepo := new(MockMenuItemUpdater)
+	mockRepo.On("GetMenuSection", ctx, restaurantID, foreignSectionID).Return(nil, domain.ErrMenuSectionNotFound)
+
+	// Execute
+	uc := NewUpdateMenuItemUseCase(mockRepo)
+	item, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, item)
+	assert.ErrorIs(t, err, domain.ErrMenuSectionNotFound)
+	mockRepo.AssertNotCalled(t, "UpdateMenuItem", mock.Anything, mock.Anything)
+}
+
+func TestUpdateMenuItemUseCase_Execute_ItemNotFound(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	sectionID := uuid.New()
+	input := UpdateMenuItemInput{
+		RestaurantID: restaurantID,
+		ItemID:       uuid.New(),
+		SectionID:    sectionID,
+		Item:         MenuItemInput{Name: "Margherita", Price: 4590},
+	}
+
+	// Mock
+	mockRepo := new(MockMenuItemUpdater)
+	mockRepo.On("GetMenuSection", ctx, restaurantID, sectionID).Return(&domain.MenuSection{ID: sectionID, RestaurantID: restaurantID}, nil)
+	mockRepo.On("UpdateMenuItem", ctx, mock.AnythingOfType("*domain.MenuItem")).Return(domain.ErrMenuItemNotFound)
+
+	// Execute
+	uc := NewUpdateMenuItemUseCase(mockRepo)
+	item, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, item)
+	assert.ErrorIs(t, err, domain.ErrMenuItemNotFound)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateMenuItemUseCase_Execute_NegativePrice(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := UpdateMenuItemInput{
+		RestaurantID: uuid.New(),
+		ItemID:       uuid.New(),
+		SectionID:    uuid.New(),
+		Item:         MenuItemInput{Name: "Margherita", Price: -1},
+	}
+
+	// Mock
+	mockRepo := new(MockMenuItemUpdater)
+
+	// Execute
+	uc := NewUpdateMenuItemUseCase(mockRepo)
+	item, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, item)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	mockRepo.AssertNotCalled(t, "GetMenuSection", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"gastro-go/internal/domain"
+)
+
+// MenuOptionGroupsUpdater define a interface mínima necessária para atualizar os grupos de opções de um item
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
+type MenuOptionGroupsUpdater interface {
+	GetMenuItem(ctx context.Context, restaurantID, id uuid.UUID) (*domain.MenuItem, error)
+	ListMenuItemOptionGroups(ctx context.Context, restaurantID, itemID uuid.UUID) ([]domain.MenuOptionGroup, error)
+	DeleteMenuOptionGroupsExcept(ctx context.Context, restaurantID, itemID uuid.UUID, keepIDs []uuid.UUID) error
+	DeleteMenuOptionsExcept(ctx context.Context, restaurantID, groupID uuid.UUID, keepIDs []uuid.UUID) error
+	CreateMenuOptionGroup(ctx context.Context, group *domain.MenuOptionGroup) error
+	UpdateMenuOptionGroup(ctx context.Context, group *domain.MenuOptionGroup) error
+	CreateMenuOption(ctx context.Context, option *domain.MenuOption) error
+	UpdateMenuOption(ctx context.Context, option *domain.MenuOption) error
+}
+
+// UpdateMenuOptionGroupsUseCase implementa o caso de uso de atualizar os grupos de opções de um item
+type UpdateMenuOptionGroupsUseCase struct {
+	repo MenuOptionGroupsUpdater
+	tx   TxRunner
+}
+
+// NewUpdateMenuOptionGroupsUseCase cria uma nova instância do use case
+func NewUpdateMenuOptionGroupsUseCase(repo MenuOptionGroupsUpdater, tx TxRunner) *UpdateMenuOptionGroupsUseCase {
+	return &UpdateMenuOptionGroupsUseCase{
+		repo: repo,
+		tx:   tx,
+	}
+}
+
+// MenuOptionGroupInput representa um grupo de opções
+type MenuOptionGroupInput struct {
+	ID            uuid.UUID // uuid.Nil cria um novo grupo
+	Name          string
+	MinSelections int
+	MaxSelections int
+	Required      bool
+	Position      int
+	Options       []MenuOptionInput
+}
+
+// MenuOptionInput representa uma opção do grupo
+type MenuOptionInput struct {
+	ID         uuid.UUID // uuid.Nil cria uma nova opção
+	Name       string
+	PriceDelta int64 // Em centavos
+	Position   int
+	Active     bool
+}
+
+// UpdateMenuOptionGroupsInput representa os dados de entrada para atualizar os grupos de um item
+type UpdateMenuOptionGroupsInput struct {
+	RestaurantID uuid.UUID
+	ItemID       uuid.UUID
+	Groups       []MenuOptionGroupInput
+}
+
+// Execute sincroniza os grupos de opções do item com o payload
+func (uc *UpdateMenuOptionGroupsUseCase) Execute(ctx context.Context, input UpdateMenuOptionGroupsInput) ([]domain.MenuOptionGroup, error) {
+	if _, err := uc.repo.GetMenuItem(ctx, input.RestaurantID, input.ItemID); err != nil {
+		return nil, fmt.Errorf("update menu option groups usecase: %w", err)
+	}
+
+	groups := make([]domain.MenuOptionGroup, 0, len(input.Groups))
+	for _, groupInput := range input.Groups {
+		options := make([]domain.MenuOption, 0, len(groupInput.Options))
+		for _, optionInput := range groupInput.Options {
+			options = append(options, domain.MenuOption{
+				ID:           optionInput.ID,
+				RestaurantID: input.RestaurantID,
+				GroupID:      groupInput.ID,
+				Name:         optionInput.Name,
+				PriceDelta:   optionInput.PriceDelta,
+				Position:     optionInput.Position,
+				Active:       optionInput.Active,
+			})
+		}
+		groups = append(groups, domain.MenuOptionGroup{
+			ID:            groupInput.ID,
+			RestaurantID:  input.RestaurantID,
+			ItemID:        input.ItemID,
+			Name:          groupInput.Name,
+			MinSelections: groupInput.MinSelections,
+			MaxSelections: groupInput.MaxSelections,
+			Required:      groupInput.Required,
+			Position:      groupInput.Position,
+			Options:       options,
+		})
+	}
+	if err := domain.ValidateMenuOptionGroups(groups); err != nil {
+		return nil, fmt.Errorf("update menu option groups usecase: %w", err)
+	}
+
+	// Sincronizar atomicamente: grupos e opções com id são atualizados (mantendo o id),
+	// os sem id são criados e os omitidos são removidos
+	err := uc.tx.RunInTx(ctx, func(ctx context.Context) error {
+		current, err := uc.repo.ListMenuItemOptionGroups(ctx, input.RestaurantID, input.ItemID)
+		if err != nil {
+			return fmt.Errorf("update menu option groups usecase: %w", err)
+		}
+		if err := checkMenuOptionRefs(current, groups); err != nil {
+			return fmt.Errorf("update menu option groups usecase: %w", err)
+		}
+
+		keepGroups := make([]uuid.UUID, 0, len(groups))
+		for i := range groups {
+			if groups[i].ID != uuid.Nil {
+				keepGroups = append(keepGroups, groups[i].ID)
+			}
+		}
+		if err := uc.repo.DeleteMenuOptionGroupsExcept(ctx, input.RestaurantID, input.ItemID, keepGroups); err != nil {
+			return fmt.Errorf("update menu option groups usecase: delete removed groups: %w", err)
+		}
+
+		for i := range groups {
+			if err := uc.saveGroup(ctx, &groups[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+// saveGroup cria ou atualiza o grupo e sincroniza as suas opções
+func (uc *UpdateMenuOptionGroupsUseCase) saveGroup(ctx context.Context, group *domain.MenuOptionGroup) error {
+	if group.ID == uuid.Nil {
+		if err := uc.repo.CreateMenuOptionGroup(ctx, group); err != nil {
+			return fmt.Errorf("update menu option groups usecase: create group: %w", err)
+		}
+	} else {
+		if err := uc.repo.UpdateMenuOptionGroup(ctx, group); err != nil {
+			return fmt.Errorf("update menu option groups usecase: update group: %w", err)
+		}
+
+		keepOptions := make([]uuid.UUID, 0, len(group.Options))
+		for i := range group.Options {
+			if group.Options[i].ID != uuid.Nil {
+				keepOptions = append(keepOptions, group.Options[i].ID)
+			}
+		}
+		if err := uc.repo.DeleteMenuOptionsExcept(ctx, group.RestaurantID, group.ID, keepOptions); err != nil {
+			return fmt.Errorf("update menu option groups usecase: delete removed options: %w", err)
+		}
+	}
+
+	for i := range group.Options {
+		option := &group.Options[i]
+		option.GroupID = group.ID
+		if option.ID == uuid.Nil {
+			if err := uc.repo.CreateMenuOption(ctx, option); err != nil {
+				return fmt.Errorf("update menu option groups usecase: create option: %w", err)
+			}
+			continue
+		}
+		if err := uc.repo.UpdateMenuOption(ctx, option); err != nil {
+			return fmt.Errorf("update menu option groups usecase: update option: %w", err)
+		}
+	}
+	return nil
+}
+
+// checkMenuOptionRefs garante que os ids informados são grupos do item e opções do mesmo grupo,
+// sem repetição
+func checkMenuOptionRefs(current, groups []domain.MenuOptionGroup) error {
+	optionsByGroup := make(map[uuid.UUID]map[uuid.UUID]bool, len(current))
+	for _, group := range current {
+		options := make(map[uuid.UUID]bool, len(group.Options))
+		for _, option := range group.Options {
+			options[option.ID] = true
+		}
+		optionsByGroup[group.ID] = options
+	}
+
+	seenGroups := make(map[uuid.UUID]bool, len(groups))
+	seenOptions := make(map[uuid.UUID]bool)
+	for _, group := range groups {
+		known := optionsByGroup[group.ID]
+		if group.ID != uuid.Nil {
+			if known == nil {
+				return domain.NewValidationError("unknown_option_group", fmt.Sprintf("option group %s does not belong to this item", group.ID))
+			}
+			if seenGroups[group.ID] {
+				return domain.NewValidationError("duplicate_option_group", fmt.Sprintf("option group %s was sent more than once", group.ID))
+			}
+			seenGroups[group.ID] = true
+		}
+
+		for _, option := range group.Options {
+			if option.ID == uuid.Nil {
+				continue
+			}
+			if !known[option.ID] {
+				return domain.NewValidationError("unknown_option", fmt.Sprintf("option %s does not belong to option group %q", option.ID, group.Name))
+			}
+			if seenOptions[option.ID] {
+				return domain.NewValidationError("duplicate_option", fmt.Sprintf("option %s was sent more than once", option.ID))
+			}
+			seenOptions[option.ID] = true
+		}
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockMenuOptionGroupsUpdater é um mock específico para MenuOptionGroupsUpdater
+type MockMenuOptionGroupsUpdater struct {
+	mock.Mock
+}
+
+func (m *MockMenuOptionGroupsUpdater) GetMenuItem(ctx context.Context, restaurantID, id uuid.UUID) (*domain.MenuItem, error) {
+	args := m.Called(ctx, restaurantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.MenuItem), args.Error(1)
+}
+
+func (m *MockMenuOptionGroupsUpdater) ListMenuItemOptionGroups(ctx context.Context, restaurantID, itemID uuid.UUID) ([]domain.MenuOptionGroup, error) {
+	args := m.Called(ctx, restaurantID, itemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.MenuOptionGroup), args.Error(1)
+}
+
+func (m *MockMenuOptionGroupsUpdater) DeleteMenuOptionGroupsExcept(ctx context.Context, restaurantID, itemID uuid.UUID, keepIDs []uuid.UUID) error {
+	args := m.Called(ctx, restaurantID, itemID, keepIDs)
+	return args.Error(0)
+}
+
+func (m *MockMenuOptionGroupsUpdater) DeleteMenuOptionsExcept(ctx context.Context, restaurantID, groupID uuid.UUID, keepIDs []uuid.UUID) error {
+	args := m.Called(ctx, restaurantID, groupID, keepIDs)
+	return args.Error(0)
+}
+
+func (m *MockMenuOptionGroupsUpdater) CreateMenuOptionGroup(ctx context.Context, group *domain.MenuOptionGroup) error {
+	args := m.Called(ctx, group)
+	group.ID = uuid.New()
+	return args.Error(0)
+}
+
+func (m *MockMenuOptionGroupsUpdater) UpdateMenuOptionGroup(ctx context.Context, group *domain.MenuOptionGroup) error {
+	args := m.Called(ctx, group)
+	return args.Error(0)
+}
+
+func (m *MockMenuOptionGroupsUpdater) CreateMenuOption(ctx context.Context, option *domain.MenuOption) error {
+	args := m.Called(ctx, option)
+	option.ID = uuid.New()
+	return args.Error(0)
+}
+
+func (m *MockMenuOptionGroupsUpdater) UpdateMenuOption(ctx context.Context, option *domain.MenuOption) error {
+	args := m.Called(ctx, option)
+	return args.Error(0)
+}
+
+func TestUpdateMenuOptionGroupsUseCase_Execute_Success(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	itemID := uuid.New()
+	input := UpdateMenuOptionGroupsInput{
+		RestaurantID: restaurantID,
+		ItemID:       itemID,
+		Groups: []MenuOptionGroupInput{{
+			Name:          "Tamanho",
+			MaxSelections: 1,
+			Required:      true,
+			Options: []MenuOptionInput{
+				{Name: "Média", Active: true},
+				{Name: "Grande", PriceDelta: 1500, Position: 1, Active: true},
+			},
+		}},
+	}
+
+	// Mock
+	mockRepo := new(MockMenuOptionGroupsUpdater)
+	mockRepo.On("GetMenuItem", ctx, restaurantID, itemID).Return(&domain.MenuItem{ID: itemID, RestaurantID: restaurantID}, nil)
+	mockRepo.On("ListMenuItemOptionGroups", mock.Anything, restaurantID, itemID).Return([]domain.MenuOptionGroup{}, nil)
+	mockRepo.On("DeleteMenuOptionGroupsExcept", mock.Anything, restaurantID, itemID, []uuid.UUID{}).Return(nil)
+	mockRepo.On("CreateMenuOptionGroup", mock.Anything, mock.AnythingOfType("*domain.MenuOptionGroup")).Return(nil).Once()
+	mockRepo.On("CreateMenuOption", mock.Anything, mock.AnythingOfType("*domain.MenuOption")).Return(nil).Twice()
+
+	// Execute
+	uc := NewUpdateMenuOptionGroupsUseCase(mockRepo, fakeTxRunner{})
+	groups, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, groups, 1)
+	assert.Equal(t, itemID, groups[0].ItemID)
+	assert.Len(t, groups[0].Options, 2)
+	assert.Equal(t, groups[0].ID, groups[0].Options[1].GroupID)
+	assert.Equal(t, int64(1500), groups[0].Options[1].PriceDelta)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateMenuOptionGroupsUseCase_Execute_InvalidLimits(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	itemID := uuid.New()
+	input := UpdateMenuOptionGroupsInput{
+		RestaurantID: restaurantID,
+		ItemID:       itemID,
+		Groups: []MenuOptionGroupInput{{
+			Name:          "Coberturas",
+			MinSelections: 4,
+			MaxSelections: 3,
+			Options:       []MenuOptionInput{{Name: "Granola", Active: true}},
+		}},
+	}
+
+	// Mock
+	mockRepo := new(MockMenuOptionGroupsUpdater)
+	mockRepo.On("GetMenuItem", ctx, restaurantID, itemID).Return(&domain.MenuItem{ID: itemID, RestaurantID: restaurantID}, nil)
+
+	// Execute
+	uc := NewUpdateMenuOptionGroupsUseCase(mockRepo, fakeTxRunner{})
+	groups, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, groups)
+	var domainErr *domain.Error
+	assert.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "invalid_selection_limits", domainErr.Code)
+	mockRepo.AssertNotCalled(t, "DeleteMenuOptionGroupsExcept", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateMenuOptionGroupsUseCase_Execute_UpsertKeepsIDs(t *testing.T) {
+	// Input: o grupo "Tamanho" é mantido com a opção "Média" renomeada, "Pequena" sai,
+	// "Grande" entra e o grupo "Adicionais" é removido
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	itemID := uuid.New()
+	sizeID := uuid.New()
+	mediumID := uuid.New()
+	smallID := uuid.New()
+	extrasID := uuid.New()
+	current := []domain.MenuOptionGroup{
+		{ID: sizeID, RestaurantID: restaurantID, ItemID: itemID, Name: "Tamanho", MaxSelections: 1, Options: []domain.MenuOption{
+			{ID: smallID, GroupID: sizeID, Name: "Pequena", Active: true},
+			{ID: mediumID, GroupID: sizeID, Name: "Média", Active: true},
+		}},
+		{ID: extrasID, RestaurantID: restaurantID, ItemID: itemID, Name: "Adicionais", MaxSelections: 3, Options: []domain.MenuOption{
+			{ID: uuid.New(), GroupID: extrasID, Name: "Bacon", Active: true},
+		}},
+	}
+	input := UpdateMenuOptionGroupsInput{
+		RestaurantID: restaurantID,
+		ItemID:       itemID,
+		Groups: []MenuOptionGroupInput{{
+			ID:            sizeID,
+			Name:          "Tamanho",
+			MaxSelections: 1,
+			Required:      true,
+			Options: []MenuOptionInput{
+				{ID: mediumID, Name: "Média (8 fatias)", Active: true},
+				{Name: "Grande", PriceDelta: 1500, Position: 1, Active: true},
+			},
+		}},
+	}
+
+	// Mock
+	mockRepo := new(MockMenuOptionGroupsUpdater)
+	mockRepo.On("GetMenuItem", ctx, restaurantID, itemID).Return(&domain.MenuItem{ID: itemID, RestaurantID: restaurantID}, nil)
+	mockRepo.On("ListMenuItemOptionGroups", mock.Anything, restaurantID, itemID).Return(current, nil)
+	mockRepo.On("DeleteMenuOptionGroupsExcept", mock.Anything, restaurantID, itemID, []uuid.UUID{sizeID}).Return(nil)
+	mockRepo.On("UpdateMenuOptionGroup", mock.Anything, mock.MatchedBy(func(group *domain.MenuOptionGroup) bool {
+		return group.ID == sizeID && group.Required
+	})).Return(nil)
+	mockRepo.On("DeleteMenuOptionsExcept", mock.Anything, restaurantID, sizeID, []uuid.UUID{mediumID}).Return(nil)
+	mockRepo.On("UpdateMenuOption", mock.Anything, mock.MatchedBy(func(option *domain.MenuOption) bool {
+		return option.ID == mediumID && option.GroupID == sizeID && option.Name == "Média (8 fatias)"
+	})).Return(nil)
+	mockRepo.On("CreateMenuOption", mock.Anything, mock.MatchedBy(func(option *domain.MenuOption) bool {
+		return option.GroupID == sizeID && option.Name == "Grande"
+	})).Return(nil)
+
+	// Execute
+	uc := NewUpdateMenuOptionGroupsUseCase(mockRepo, fakeTxRunner{})
+	groups, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, groups, 1)
+	assert.Equal(t, sizeID, groups[0].ID)
+	assert.Equal(t, mediumID, groups[0].Options[0].ID)
+	assert.NotEqual(t, uuid.Nil, groups[0].Options[1].ID)
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "CreateMenuOptionGroup", mock.Anything, mock.Anything)
+}
+
+func TestUpdateMenuOptionGroupsUseCase_Execute_UnknownIDs(t *testing.T) {
+	restaurantID := uuid.New()
+	itemID := uuid.New()
+	groupID := uuid.New()
+	optionID := uuid.New()
+	current := []domain.MenuOptionGroup{{ID: groupID, RestaurantID: restaurantID, ItemID: itemID, Name: "Tamanho", MaxSelections: 1, Options: []domain.MenuOption{
+		{ID: optionID, GroupID: groupID, Name: "Média", Active: true},
+	}}}
+	option := MenuOptionInput{ID: optionID, Name: "Média", Active: true}
+
+	tests := []struct {
+		name   string
+		groups []MenuOptionGroupInput
+		code   string
+	}{
+		{name: "group from another item", groups: []MenuOptionGroupInput{{ID: uuid.New(), Name: "Tamanho", MaxSelections: 1, Options: []MenuOptionInput{option}}}, code: "unknown_option_group"},
+		{name: "option moved to a new group", groups: []MenuOptionGroupInput{{Name: "Tamanho", MaxSelections: 1, Options: []MenuOptionInput{option}}}, code: "unknown_option"},
+		{name: "unknown option", groups: []MenuOptionGroupInput{{ID: groupID, Name: "Tamanho", MaxSelections: 1, Options: []MenuOptionInput{{ID: uuid.New(), Name: "Grande", Active: true}}}}, code: "unknown_option"},
+		{name: "duplicate group", groups: []MenuOptionGroupInput{
+			{ID: groupID, Name: "Tamanho", MaxSelections: 1, Options: []MenuOptionInput{{Name: "Grande", Active: true}}},
+			{ID: groupID, Name: "Tamanho", MaxSelections: 1, Options: []MenuOptionInput{{Name: "Pequena", Active: true}}},
+		}, code: "duplicate_option_group"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Input
+			ctx := context.Background()
+			input := UpdateMenuOptionGroupsInput{RestaurantID: restaurantID, ItemID: itemID, Groups: tt.groups}
+
+			// Mock
+			mockRepo := new(MockMenuOptionGroupsUpdater)
+			mockRepo.On("GetMenuItem", ctx, restaurantID, itemID).Return(&domain.MenuItem{ID: itemID, RestaurantID: restaurantID}, nil)
+			mockRepo.On("ListMenuItemOptionGroups", mock.Anything, restaurantID, itemID).Return(current, nil)
+
+			// Execute
+			uc := NewUpdateMenuOptionGroupsUseCase(mockRepo, fakeTxRunner{})
+			groups, err := uc.Execute(ctx, input)
+
+			// Assert
+			assert.Nil(t, groups)
+			var domainErr *domain.Error
+			assert.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, tt.code, domainErr.Code)
+			mockRepo.AssertNotCalled(t, "DeleteMenuOptionGroupsExcept", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"gastro-go/internal/domain"
+)
+
+// MenuSectionUpdater define a interface mínima necessária para atualizar seções do cardápio
+// Segue Interface Segregation Principle: apenas o método que este use case precisa
+type MenuSectionUpdater interface {
+	UpdateMenuSection(ctx context.Context, section *domain.MenuSection) error
+}
+
+// UpdateMenuSectionUseCase implementa o caso de uso de atualização de seção do cardápio
+type UpdateMenuSectionUseCase struct {
+	repo MenuSectionUpdater
+}
+
+// NewUpdateMenuSectionUseCase cria uma nova instância do use case
+func NewUpdateMenuSectionUseCase(repo MenuSectionUpdater) *UpdateMenuSectionUseCase {
+	return &UpdateMenuSectionUseCase{
+		repo: repo,
+	}
+}
+
+// UpdateMenuSectionInput representa os dados de entrada para atualizar uma seção
+type UpdateMenuSectionInput struct {
+	RestaurantID uuid.UUID
+	SectionID    uuid.UUID
+	Section      MenuSectionInput
+}
+
+// Execute substitui os dados da seção
+func (uc *UpdateMenuSectionUseCase) Execute(ctx context.Context, input UpdateMenuSectionInput) (*domain.MenuSection, error) {
+	section := input.Section.toDomain(input.RestaurantID)
+	section.ID = input.SectionID
+	if err := section.Validate(); err != nil {
+		return nil, fmt.Errorf("update menu section usecase: %w", err)
+	}
+
+	if err := uc.repo.UpdateMenuSection(ctx, section); err != nil {
+		return nil, fmt.Errorf("update menu section usecase: %w", err)
+	}
+
+	return section, nil
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"gastro-go/internal/domain"
+)
+
+// MenuSectionAvailabilityUpdater define a interface mínima necessária para atualizar as janelas de venda de uma seção
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
+type MenuSectionAvailabilityUpdater interface {
+	GetMenuSection(ctx context.Context, restaurantID, id uuid.UUID) (*domain.MenuSection, error)
+	DeleteMenuSectionAvailability(ctx context.Context, restaurantID, sectionID uuid.UUID) error
+	CreateMenuSectionWindow(ctx context.Context, sectionID uuid.UUID, window *domain.OpeningHour) error
+}
+
+// UpdateMenuSectionAvailabilityUseCase implementa o caso de uso de atualizar as janelas de venda de uma seção
+type UpdateMenuSectionAvailabilityUseCase struct {
+	repo MenuSectionAvailabilityUpdater
+	tx   TxRunner
+}
+
+// NewUpdateMenuSectionAvailabilityUseCase cria uma nova instância do use case
+func NewUpdateMenuSectionAvailabilityUseCase(repo MenuSectionAvailabilityUpdater, tx TxRunner) *UpdateMenuSectionAvailabilityUseCase {
+	return &UpdateMenuSectionAvailabilityUseCase{
+		repo: repo,
+		tx:   tx,
+	}
+}
+
+// UpdateMenuSectionAvailabilityInput representa os dados de entrada para atualizar as janelas de uma seção
+// Sem janelas, a seção fica sempre disponível
+type UpdateMenuSectionAvailabilityInput struct {
+	RestaurantID uuid.UUID
+	SectionID    uuid.UUID
+	Windows      []OpeningHourInput
+}
+
+// Execute substitui as janelas de venda da seção
+func (uc *UpdateMenuSectionAvailabilityUseCase) Execute(ctx context.Context, input UpdateMenuSectionAvailabilityInput) ([]domain.OpeningHour, error) {
+	if _, err := uc.repo.GetMenuSection(ctx, input.RestaurantID, input.SectionID); err != nil {
+		return nil, fmt.Errorf("update menu section availability usecase: %w", err)
+	}
+
+	windows, err := availabilityWindows(input.RestaurantID, input.Windows)
+	if err != nil {
+		return nil, fmt.Errorf("update menu section availability usecase: %w", err)
+	}
+
+	// Substituir janelas atomicamente: uma falha no meio não apaga as janelas atuais
+	err = uc.tx.RunInTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.DeleteMenuSectionAvailability(ctx, input.RestaurantID, input.SectionID); err != nil {
+			return fmt.Errorf("update menu section availability usecase: delete existing windows: %w", err)
+		}
+
+		for i := range windows {
+			if err := uc.repo.CreateMenuSectionWindow(ctx, input.SectionID, &windows[i]); err != nil {
+				return fmt.Errorf("update menu section availability usecase: create window: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return windows, nil
+}
+
+// availabilityWindows converte e valida as janelas de venda com as mesmas regras dos horários de funcionamento
+func availabilityWindows(restaurantID uuid.UUID, inputs []OpeningHourInput) ([]domain.OpeningHour, error) {
+	windows := make([]domain.OpeningHour, 0, len(inputs))
+	for _, windowInput := range inputs {
+		windows = append(windows, domain.OpeningHour{
+			RestaurantID: restaurantID,
+			Weekday:      windowInput.Weekday,
+			OpensAt:      windowInput.OpensAt,
+			ClosesAt:     windowInput.ClosesAt,
+		})
+	}
+	if err := domain.ValidateOpeningHours(windows); err != nil {
+		return nil, err
+	}
+	return windows, nil
+}
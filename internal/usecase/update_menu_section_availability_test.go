@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockMenuSectionAvailabilityUpdater é um mock específico para MenuSectionAvailabilityUpdater
+type MockMenuSectionAvailabilityUpdater struct {
+	mock.Mock
+}
+
+func (m *MockMenuSectionAvailabilityUpdater) GetMenuSection(ctx context.Context, restaurantID, id uuid.UUID) (*domain.MenuSection, error) {
+	args := m.Called(ctx, restaurantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.MenuSection), args.Error(1)
+}
+
+func (m *MockMenuSectionAvailabilityUpdater) DeleteMenuSectionAvailability(ctx context.Context, restaurantID, sectionID uuid.UUID) error {
+	args := m.Called(ctx, restaurantID, sectionID)
+	return args.Error(0)
+}
+
+func (m *MockMenuSectionAvailabilityUpdater) CreateMenuSectionWindow(ctx context.Context, sectionID uuid.UUID, window *domain.OpeningHour) error {
+	args := m.Called(ctx, sectionID, window)
+	return args.Error(0)
+}
+
+func TestUpdateMenuSectionAvailabilityUseCase_Execute_Success(t *testing.T) {
+	// Input: café da manhã todos os dias, 07:00–10:30
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	sectionID := uuid.New()
+	input := UpdateMenuSectionAvailabilityInput{RestaurantID: restaurantID, SectionID: sectionID}
+	for weekday := 0; weekday <= 6; weekday++ {
+		input.Windows = append(input.Windows, OpeningHourInput{Weekday: weekday, OpensAt: 420, ClosesAt: 630})
+	}
+
+	// Mock
+	mockRepo := new(MockMenuSectionAvailabilityUpdater)
+	mockRepo.On("GetMenuSection", ctx, restaurantID, sectionID).Return(&domain.MenuSection{ID: sectionID, RestaurantID: restaurantID}, nil)
+	mockRepo.On("DeleteMenuSectionAvailability", mock.Anything, restaurantID, sectionID).Return(nil)
+	mockRepo.On("CreateMenuSectionWindow", mock.Anything, sectionID, mock.AnythingOfType("*domain.OpeningHour")).Return(nil).Times(7)
+
+	// Execute
+	uc := NewUpdateMenuSectionAvailabilityUseCase(mockRepo, fakeTxRunner{})
+	windows, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, windows, 7)
+	assert.Equal(t, restaurantID, windows[0].RestaurantID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateMenuSectionAvailabilityUseCase_Execute_Overlap(t *testing.T) {
+	// Input: duas janelas de segunda que se cruzam
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	sectionID := uuid.New()
+	input := UpdateMenuSectionAvailabilityInput{
+		RestaurantID: restaurantID,
+		SectionID:    sectionID,
+		Windows: []OpeningHourInput{
+			{Weekday: 1, OpensAt: 660, ClosesAt: 900},
+			{Weekday: 1, OpensAt: 840, ClosesAt: 960},
+		},
+	}
+
+	// Mock
+	mockRepo := new(MockMenuSectionAvailabilityUpdater)
+	mockRepo.On("GetMenuSection", ctx, restaurantID, sectionID).Return(&domain.MenuSection{ID: sectionID, RestaurantID: restaurantID}, nil)
+
+	// Execute
+	uc := NewUpdateMenuSectionAvailabilityUseCase(mockRepo, fakeTxRunner{})
+	windows, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, windows)
+	var domainErr *domain.Error
+	assert.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "opening_hours_overlap", domainErr.Code)
+	mockRepo.AssertNotCalled(t, "DeleteMenuSectionAvailability", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateMenuSectionAvailabilityUseCase_Execute_NotFound(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	sectionID := uuid.New()
+	input := UpdateMenuSectionAvailabilityInput{
+		RestaurantID: restaurantID,
+		SectionID:    sectionID,
+		Windows:      []OpeningHourInput{{Weekday: 1, OpensAt: 660, ClosesAt: 900}},
+	}
+
+	// Mock
+	mockRepo := new(MockMenuSectionAvailabilityUpdater)
+	mockRepo.On("GetMenuSection", ctx, restaurantID, sectionID).Return(nil, domain.ErrMenuSectionNotFound)
+
+	// Execute
+	uc := NewUpdateMenuSectionAvailabilityUseCase(mockRepo, fakeTxRunner{})
+	windows, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, windows)
+	assert.ErrorIs(t, err, domain.ErrMenuSectionNotFound)
+	mockRepo.AssertNotCalled(t, "DeleteMenuSectionAvailability", mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "CreateMenuSectionWindow", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockMenuSectionUpdater é um mock específico para MenuSectionUpdater
+type MockMenuSectionUpdater struct {
+	mock.Mock
+}
+
+func (m *MockMenuSectionUpdater) UpdateMenuSection(ctx context.Context, section *domain.MenuSection) error {
+	args := m.Called(ctx, section)
+	return args.Error(0)
+}
+
+func TestUpdateMenuSectionUseCase_Execute_Success(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	sectionID := uuid.New()
+	input := UpdateMenuSectionInput{
+		RestaurantID: restaurantID,
+		SectionID:    sectionID,
+		Section:      MenuSectionInput{Name: "Bebidas", Position: 3},
+	}
+
+	// Mock
+	mockRepo := new(MockMenuSectionUpdater)
+	mockRepo.On("UpdateMenuSection", ctx, mock.MatchedBy(func(s *domain.MenuSection) bool {
+		return s.ID == sectionID && s.RestaurantID == restaurantID && s.Name == "Bebidas" && !s.Active
+	})).Return(nil)
+
+	// Execute
+	uc := NewUpdateMenuSectionUseCase(mockRepo)
+	section, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, sectionID, section.ID)
+	assert.Equal(t, 3, section.Position)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateMenuSectionUseCase_Execute_SectionNotFound(t *testing.T) {
+	// Input: seção de outro restaurante também não é encontrada
+	ctx := context.Background()
+	input := UpdateMenuSectionInput{
+		RestaurantID: uuid.New(),
+		SectionID:    uuid.New(),
+		Section:      MenuSectionInput{Name: "Bebidas"},
+	}
+
+	// Mock
+	mockRepo := new(MockMenuSectionUpdater)
+	mockRepo.On("UpdateMenuSection", ctx, mock.AnythingOfType("*domain.MenuSection")).Return(domain.ErrMenuSectionNotFound)
+
+	// Execute
+	uc := NewUpdateMenuSectionUseCase(mockRepo)
+	section, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, section)
+	assert.ErrorIs(t, err, domain.ErrMenuSectionNotFound)
+}
+
+func TestUpdateMenuSectionUseCase_Execute_NegativePosition(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	input := UpdateMenuSectionInput{
+		RestaurantID: uuid.New(),
+		SectionID:    uuid.New(),
+		Section:      MenuSectionInput{Name: "Bebidas", Position: -1},
+	}
+
+	// Mock
+	mockRepo := new(MockMenuSectionUpdater)
+
+	// Execute
+	uc := NewUpdateMenuSectionUseCase(mockRepo)
+	section, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, section)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	mockRepo.AssertNotCalled(t, "UpdateMenuSection", mock.Anything, mock.Anything)
+}
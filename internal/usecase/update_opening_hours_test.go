@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockOpeningHoursUpdater é um mock específico para OpeningHoursUpdater
+type MockOpeningHoursUpdater struct {
+	mock.Mock
+}
+
+func (m *MockOpeningHoursUpdater) GetByID(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Restaurant), args.Error(1)
+}
+
+func (m *MockOpeningHoursUpdater) DeleteOpeningHoursByRestaurant(ctx context.Context, restaurantID uuid.UUID) error {
+	args := m.Called(ctx, restaurantID)
+	return args.Error(0)
+}
+
+func (m *MockOpeningHoursUpdater) CreateOpeningHour(ctx context.Context, hour *domain.OpeningHour) error {
+	args := m.Called(ctx, hour)
+	return args.Error(0)
+}
+
+func TestUpdateOpeningHoursUseCase_Execute_CrossMidnight(t *testing.T) {
+	// Input: sexta 22:00-02:00 transborda para o sábado sem colidir com sábado 10:00-14:00
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := UpdateOpeningHoursInput{
+		RestaurantID: restaurantID,
+		Hours: []OpeningHourInput{
+			{Weekday: 5, OpensAt: 22 * 60, ClosesAt: 2 * 60},
+			{Weekday: 6, OpensAt: 10 * 60, ClosesAt: 14 * 60},
+		},
+	}
+
+	// Mock
+	mockRepo := new(MockOpeningHoursUpdater)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID}, nil)
+	mockRepo.On("DeleteOpeningHoursByRestaurant", ctx, restaurantID).Return(nil)
+	mockRepo.On("CreateOpeningHour", ctx, mock.MatchedBy(func(hour *domain.OpeningHour) bool {
+		return hour.RestaurantID == restaurantID && hour.Weekday == 5 && hour.ClosesAt == 2*60
+	})).Return(nil).Once()
+	mockRepo.On("CreateOpeningHour", ctx, mock.MatchedBy(func(hour *domain.OpeningHour) bool {
+		return hour.RestaurantID == restaurantID && hour.Weekday == 6
+	})).Return(nil).Once()
+
+	// Execute
+	uc := NewUpdateOpeningHoursUseCase(mockRepo, fakeTxRunner{})
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateOpeningHoursUseCase_Execute_Overlap(t *testing.T) {
+	tests := []struct {
+		name  string
+		hours []OpeningHourInput
+	}{
+		{"same day", []OpeningHourInput{{Weekday: 1, OpensAt: 600, ClosesAt: 900}, {Weekday: 1, OpensAt: 840, ClosesAt: 1080}}},
+		{"spill-over into next day", []OpeningHourInput{{Weekday: 5, OpensAt: 22 * 60, ClosesAt: 3 * 60}, {Weekday: 6, OpensAt: 2 * 60, ClosesAt: 10 * 60}}},
+		{"saturday wraps into sunday", []OpeningHourInput{{Weekday: 6, OpensAt: 23 * 60, ClosesAt: 2 * 60}, {Weekday: 0, OpensAt: 60, ClosesAt: 5 * 60}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Input
+			ctx := context.Background()
+			restaurantID := uuid.New()
+			input := UpdateOpeningHoursInput{RestaurantID: restaurantID, Hours: tt.hours}
+
+			// Mock
+			mockRepo := new(MockOpeningHoursUpdater)
+			mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID}, nil)
+
+			// Execute
+			uc := NewUpdateOpeningHoursUseCase(mockRepo, fakeTxRunner{})
+			err := uc.Execute(ctx, input)
+
+			// Assert
+			assert.ErrorIs(t, err, domain.NewValidationError("opening_hours_overlap", ""))
+			mockRepo.AssertNotCalled(t, "DeleteOpeningHoursByRestaurant", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestUpdateOpeningHoursUseCase_Execute_EmptyInterval(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := UpdateOpeningHoursInput{
+		RestaurantID: restaurantID,
+		Hours:        []OpeningHourInput{{Weekday: 2, OpensAt: 600, ClosesAt: 600}},
+	}
+
+	// Mock
+	mockRepo := new(MockOpeningHoursUpdater)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID}, nil)
+
+	// Execute
+	uc := NewUpdateOpeningHoursUseCase(mockRepo, fakeTxRunner{})
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.NewValidationError("empty_interval", ""))
+	mockRepo.AssertNotCalled(t, "DeleteOpeningHoursByRestaurant", mock.Anything, mock.Anything)
+}
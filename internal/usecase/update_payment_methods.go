@@ -20,12 +20,14 @@ type PaymentMethodsUpdater interface {
 // UpdatePaymentMethodsUseCase implementa o caso de uso de atualizar métodos de pagamento
 type UpdatePaymentMethodsUseCase struct {
 	repo PaymentMethodsUpdater
+	tx   TxRunner
 }
 
 // NewUpdatePaymentMethodsUseCase cria uma nova instância do use case
-func NewUpdatePaymentMethodsUseCase(repo PaymentMethodsUpdater) *UpdatePaymentMethodsUseCase {
+func NewUpdatePaymentMethodsUseCase(repo PaymentMethodsUpdater, tx TxRunner) *UpdatePaymentMethodsUseCase {
 	return &UpdatePaymentMethodsUseCase{
 		repo: repo,
+		tx:   tx,
 	}
 }
 
@@ -52,28 +54,29 @@ func (uc *UpdatePaymentMethodsUseCase) Execute(ctx context.Context, input Update
 
 	for _, method := range input.Methods {
 		if !validMethods[method] {
-			return fmt.Errorf("update payment methods usecase: invalid payment method: %s", method)
+			return fmt.Errorf("update payment methods usecase: %w", domain.NewValidationError("invalid_payment_method", fmt.Sprintf("invalid payment method: %s", method)))
 		}
 	}
 
-	// Deletar métodos existentes
-	if err := uc.repo.DeletePaymentMethodsByRestaurant(ctx, input.RestaurantID); err != nil {
-		return fmt.Errorf("update payment methods usecase: delete existing methods: %w", err)
-	}
-
-	// Criar novos métodos
-	for _, methodStr := range input.Methods {
-		method := &domain.PaymentMethod{
-			ID:           uuid.New(),
-			RestaurantID: input.RestaurantID,
-			Method:       methodStr,
+	// Substituir métodos atomicamente: uma falha no meio não apaga os métodos atuais
+	return uc.tx.RunInTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.DeletePaymentMethodsByRestaurant(ctx, input.RestaurantID); err != nil {
+			return fmt.Errorf("update payment methods usecase: delete existing methods: %w", err)
 		}
 
-		if err := uc.repo.CreatePaymentMethod(ctx, method); err != nil {
-			return fmt.Errorf("update payment methods usecase: create method: %w", err)
+		for _, methodStr := range input.Methods {
+			method := &domain.PaymentMethod{
+				ID:           uuid.New(),
+				RestaurantID: input.RestaurantID,
+				Method:       methodStr,
+			}
+
+			if err := uc.repo.CreatePaymentMethod(ctx, method); err != nil {
+				return fmt.Errorf("update payment methods usecase: create method: %w", err)
+			}
 		}
-	}
 
-	return nil
+		return nil
+	})
 }
 
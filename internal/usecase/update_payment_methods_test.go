@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockPaymentMethodsUpdater é um mock específico para PaymentMethodsUpdater
+type MockPaymentMethodsUpdater struct {
+	mock.Mock
+}
+
+func (m *MockPaymentMethodsUpdater) GetByID(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Restaurant), args.Error(1)
+}
+
+func (m *MockPaymentMethodsUpdater) DeletePaymentMethodsByRestaurant(ctx context.Context, restaurantID uuid.UUID) error {
+	args := m.Called(ctx, restaurantID)
+	return args.Error(0)
+}
+
+func (m *MockPaymentMethodsUpdater) CreatePaymentMethod(ctx context.Context, method *domain.PaymentMethod) error {
+	args := m.Called(ctx, method)
+	return args.Error(0)
+}
+
+func TestUpdatePaymentMethodsUseCase_Execute_Success(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := UpdatePaymentMethodsInput{
+		RestaurantID: restaurantID,
+		Methods:      []string{domain.PaymentMethodPIX, domain.PaymentMethodCreditCard},
+	}
+
+	// Mock
+	mockRepo := new(MockPaymentMethodsUpdater)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID}, nil)
+	mockRepo.On("DeletePaymentMethodsByRestaurant", ctx, restaurantID).Return(nil)
+	mockRepo.On("CreatePaymentMethod", ctx, mock.AnythingOfType("*domain.PaymentMethod")).Return(nil).Twice()
+
+	// Execute
+	uc := NewUpdatePaymentMethodsUseCase(mockRepo, fakeTxRunner{})
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdatePaymentMethodsUseCase_Execute_InvalidMethod(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := UpdatePaymentMethodsInput{
+		RestaurantID: restaurantID,
+		Methods:      []string{domain.PaymentMethodPIX, "BITCOIN"},
+	}
+
+	// Mock
+	mockRepo := new(MockPaymentMethodsUpdater)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID}, nil)
+
+	// Execute
+	uc := NewUpdatePaymentMethodsUseCase(mockRepo, fakeTxRunner{})
+	err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	mockRepo.AssertNotCalled(t, "DeletePaymentMethodsByRestaurant", mock.Anything, mock.Anything)
+}
+
+func TestUpdatePaymentMethodsUseCase_Execute_CreateFailurePropagates(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	input := UpdatePaymentMethodsInput{
+		RestaurantID: restaurantID,
+		Methods:      []string{domain.PaymentMethodPIX},
+	}
+	dbErr := errors.New("connection reset")
+
+	// Mock
+	mockRepo := new(MockPaymentMethodsUpdater)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID}, nil)
+	mockRepo.On("DeletePaymentMethodsByRestaurant", ctx, restaurantID).Return(nil)
+	mockRepo.On("CreatePaymentMethod", ctx, mock.AnythingOfType("*domain.PaymentMethod")).Return(dbErr)
+
+	// Execute
+	uc := NewUpdatePaymentMethodsUseCase(mockRepo, fakeTxRunner{})
+	err := uc.Execute(ctx, input)
+
+	// Assert: o erro chega ao TxRunner, que faz rollback da remoção
+	assert.ErrorIs(t, err, dbErr)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"gastro-go/internal/domain"
+)
+
+// RestaurantProfileUpdater define a interface mínima necessária para atualizar o perfil de restaurantes
+// Segue Interface Segregation Principle: apenas os métodos que este use case precisa
+type RestaurantProfileUpdater interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error)
+	UpdateProfile(ctx context.Context, restaurant *domain.Restaurant, expectedVersion int) error
+	SlugExists(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error)
+	RetireSlug(ctx context.Context, restaurantID uuid.UUID, oldSlug, newSlug string) error
+	ReplaceCategories(ctx context.Context, restaurantID uuid.UUID, categories []domain.Category) error
+	CategoryResolver
+}
+
+// UpdateRestaurantProfileUseCase implementa o caso de uso de atualização parcial do perfil
+type UpdateRestaurantProfileUseCase struct {
+	repo RestaurantProfileUpdater
+	tx   TxRunner
+}
+
+// NewUpdateRestaurantProfileUseCase cria uma nova instância do use case
+func NewUpdateRestaurantProfileUseCase(repo RestaurantProfileUpdater, tx TxRunner) *UpdateRestaurantProfileUseCase {
+	return &UpdateRestaurantProfileUseCase{
+		repo: repo,
+		tx:   tx,
+	}
+}
+
+// UpdateRestaurantProfileInput representa os dados de entrada para atualizar o perfil
+// Campos nil não são alterados (semântica de merge patch)
+type UpdateRestaurantProfileInput struct {
+	RestaurantID       uuid.UUID
+	ExpectedVersion    *int // Versão informada pelo cliente (If-Match); nil aceita qualquer versão
+	Name               *string
+	Slug               *string // Troca o slug; o antigo passa a redirecionar para o novo
+	Description        *string
+	Categories         *[]string // IDs ou slugs de categorias cadastradas; a primeira é a principal
+	DeliveryFee        *int64
+	MinOrderValue      *int64
+	PreparationTimeMin *int
+	SupportsPickup     *bool
+	SupportsDelivery   *bool
+	LogoURL            *string
+	BannerURL          *string
+	Timezone           *string // "" remove o fuso explícito
+}
+
+// Execute executa o caso de uso de atualização de perfil
+func (uc *UpdateRestaurantProfileUseCase) Execute(ctx context.Context, input UpdateRestaurantProfileInput) (*domain.Restaurant, error) {
+	restaurant, err := uc.repo.GetByID(ctx, input.RestaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("update restaurant profile usecase: %w", err)
+	}
+
+	// Rejeitar cedo se o cliente editou uma versão desatualizada
+	if input.ExpectedVersion != nil && *input.ExpectedVersion != restaurant.Version {
+		return nil, fmt.Errorf("update restaurant profile usecase: %w", domain.ErrVersionMismatch)
+	}
+	loadedVersion := restaurant.Version
+	oldSlug := restaurant.Slug
+
+	applyProfilePatch(restaurant, input)
+
+	if err := restaurant.ValidateProfile(); err != nil {
+		return nil, fmt.Errorf("update restaurant profile usecase: %w", err)
+	}
+
+	// Categorias são da taxonomia: substituem as atuais e a primeira vira a principal
+	if input.Categories != nil {
+		categories, err := resolveCategories(ctx, uc.repo, *input.Categories)
+		if err != nil {
+			return nil, fmt.Errorf("update restaurant profile usecase: %w", err)
+		}
+		if err := restaurant.SetCategories(categories); err != nil {
+			return nil, fmt.Errorf("update restaurant profile usecase: %w", err)
+		}
+	}
+
+	if input.Slug != nil {
+		if err := uc.prepareSlug(ctx, restaurant, *input.Slug); err != nil {
+			return nil, fmt.Errorf("update restaurant profile usecase: %w", err)
+		}
+	}
+
+	// A versão carregada protege contra escritas concorrentes entre a leitura e o update
+	err = uc.tx.RunInTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.UpdateProfile(ctx, restaurant, loadedVersion); err != nil {
+			return err
+		}
+		if input.Categories != nil {
+			if err := uc.repo.ReplaceCategories(ctx, restaurant.ID, restaurant.Categories); err != nil {
+				return err
+			}
+		}
+		if restaurant.Slug == oldSlug {
+			return nil
+		}
+		return uc.repo.RetireSlug(ctx, restaurant.ID, oldSlug, restaurant.Slug)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update restaurant profile usecase: %w", err)
+	}
+
+	return restaurant, nil
+}
+
+// prepareSlug valida o novo slug e verifica se está livre
+// Slugs atuais ou aposentados de outros restaurantes não podem ser reutilizados
+func (uc *UpdateRestaurantProfileUseCase) prepareSlug(ctx context.Context, restaurant *domain.Restaurant, slug string) error {
+	if slug == restaurant.Slug {
+		return nil
+	}
+	if err := domain.ValidateSlug(slug); err != nil {
+		return err
+	}
+
+	exists, err := uc.repo.SlugExists(ctx, slug, restaurant.ID)
+	if err != nil {
+		return fmt.Errorf("check slug uniqueness: %w", err)
+	}
+	if exists {
+		return domain.ErrSlugAlreadyExists
+	}
+
+	restaurant.Slug = slug
+	return nil
+}
+
+// applyProfilePatch copia para o restaurante apenas os campos informados
+func applyProfilePatch(restaurant *domain.Restaurant, input UpdateRestaurantProfileInput) {
+	if input.Name != nil {
+		restaurant.Name = *input.Name
+	}
+	if input.Description != nil {
+		restaurant.Description = *input.Description
+	}
+	if input.DeliveryFee != nil {
+		restaurant.DeliveryFee = *input.DeliveryFee
+	}
+	if input.MinOrderValue != nil {
+		restaurant.MinOrderValue = *input.MinOrderValue
+	}
+	if input.PreparationTimeMin != nil {
+		restaurant.PreparationTimeMin = *input.PreparationTimeMin
+	}
+	if input.SupportsPickup != nil {
+		restaurant.SupportsPickup = *input.SupportsPickup
+	}
+	if input.SupportsDelivery != nil {
+		restaurant.SupportsDelivery = *input.SupportsDelivery
+	}
+	if input.LogoURL != nil {
+		restaurant.LogoURL = *input.LogoURL
+	}
+	if input.BannerURL != nil {
+		restaurant.BannerURL = *input.BannerURL
+	}
+	if input.Timezone != nil {
+		restaurant.Timezone = *input.Timezone
+	}
+}
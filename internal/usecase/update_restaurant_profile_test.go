@@ -0,0 +1,278 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gastro-go/internal/domain"
+)
+
+// MockRestaurantProfileUpdater é um mock específico para RestaurantProfileUpdater
+type MockRestaurantProfileUpdater struct {
+	mock.Mock
+}
+
+func (m *MockRestaurantProfileUpdater) GetByID(ctx context.Context, id uuid.UUID) (*domain.Restaurant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Restaurant), args.Error(1)
+}
+
+func (m *MockRestaurantProfileUpdater) UpdateProfile(ctx context.Context, restaurant *domain.Restaurant, expectedVersion int) error {
+	args := m.Called(ctx, restaurant, expectedVersion)
+	return args.Error(0)
+}
+
+func (m *MockRestaurantProfileUpdater) SlugExists(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, slug, excludeID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRestaurantProfileUpdater) RetireSlug(ctx context.Context, restaurantID uuid.UUID, oldSlug, newSlug string) error {
+	args := m.Called(ctx, restaurantID, oldSlug, newSlug)
+	return args.Error(0)
+}
+
+func (m *MockRestaurantProfileUpdater) ReplaceCategories(ctx context.Context, restaurantID uuid.UUID, categories []domain.Category) error {
+	args := m.Called(ctx, restaurantID, categories)
+	return args.Error(0)
+}
+
+func (m *MockRestaurantProfileUpdater) ResolveCategories(ctx context.Context, ids []uuid.UUID, slugs []string) ([]domain.Category, error) {
+	args := m.Called(ctx, ids, slugs)
+	return args.Get(0).([]domain.Category), args.Error(1)
+}
+
+func TestUpdateRestaurantProfileUseCase_Execute_Success(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	newName := "Pizza do João & Filhos"
+	newFee := int64(700)
+	version := 3
+	input := UpdateRestaurantProfileInput{
+		RestaurantID:    restaurantID,
+		ExpectedVersion: &version,
+		Name:            &newName,
+		DeliveryFee:     &newFee,
+	}
+
+	// Mock
+	current := &domain.Restaurant{
+		ID:            restaurantID,
+		Name:          "Pizza do João",
+		Description:   "Melhor pizza da cidade",
+		DeliveryFee:   500,
+		MinOrderValue: 2000,
+		Version:       3,
+	}
+	mockRepo := new(MockRestaurantProfileUpdater)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(current, nil)
+	mockRepo.On("UpdateProfile", ctx, current, 3).Return(nil)
+
+	// Execute
+	uc := NewUpdateRestaurantProfileUseCase(mockRepo, fakeTxRunner{})
+	restaurant, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Pizza do João & Filhos", restaurant.Name)
+	assert.Equal(t, int64(700), restaurant.DeliveryFee)
+	assert.Equal(t, "Melhor pizza da cidade", restaurant.Description) // campo ausente não muda
+	assert.Equal(t, int64(2000), restaurant.MinOrderValue)
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "RetireSlug", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateRestaurantProfileUseCase_Execute_RenameSlug(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	newSlug := "pizzaria-joao"
+	input := UpdateRestaurantProfileInput{
+		RestaurantID: restaurantID,
+		Slug:         &newSlug,
+	}
+
+	// Mock
+	current := &domain.Restaurant{ID: restaurantID, Name: "Pizza", Slug: "pizza-do-joao", Version: 1}
+	mockRepo := new(MockRestaurantProfileUpdater)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(current, nil)
+	mockRepo.On("SlugExists", ctx, "pizzaria-joao", restaurantID).Return(false, nil)
+	mockRepo.On("UpdateProfile", ctx, current, 1).Return(nil)
+	mockRepo.On("RetireSlug", ctx, restaurantID, "pizza-do-joao", "pizzaria-joao").Return(nil)
+
+	// Execute
+	uc := NewUpdateRestaurantProfileUseCase(mockRepo, fakeTxRunner{})
+	restaurant, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "pizzaria-joao", restaurant.Slug)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateRestaurantProfileUseCase_Execute_SlugTaken(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	newSlug := "pizzaria-joao"
+	input := UpdateRestaurantProfileInput{
+		RestaurantID: restaurantID,
+		Slug:         &newSlug,
+	}
+
+	// Mock: slug em uso (ou aposentado) por outro restaurante
+	mockRepo := new(MockRestaurantProfileUpdater)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID, Name: "Pizza", Slug: "pizza-do-joao", Version: 1}, nil)
+	mockRepo.On("SlugExists", ctx, "pizzaria-joao", restaurantID).Return(true, nil)
+
+	// Execute
+	uc := NewUpdateRestaurantProfileUseCase(mockRepo, fakeTxRunner{})
+	restaurant, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, restaurant)
+	assert.ErrorIs(t, err, domain.ErrSlugAlreadyExists)
+	mockRepo.AssertNotCalled(t, "UpdateProfile", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateRestaurantProfileUseCase_Execute_StaleVersion(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	newName := "Outro nome"
+	staleVersion := 1
+	input := UpdateRestaurantProfileInput{
+		RestaurantID:    restaurantID,
+		ExpectedVersion: &staleVersion,
+		Name:            &newName,
+	}
+
+	// Mock
+	mockRepo := new(MockRestaurantProfileUpdater)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID, Name: "Pizza", Version: 2}, nil)
+
+	// Execute
+	uc := NewUpdateRestaurantProfileUseCase(mockRepo, fakeTxRunner{})
+	restaurant, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, restaurant)
+	assert.ErrorIs(t, err, domain.ErrPreconditionFailed)
+	mockRepo.AssertNotCalled(t, "UpdateProfile", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateRestaurantProfileUseCase_Execute_NegativeMinOrderValue(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	negative := int64(-1)
+	input := UpdateRestaurantProfileInput{
+		RestaurantID:  restaurantID,
+		MinOrderValue: &negative,
+	}
+
+	// Mock
+	mockRepo := new(MockRestaurantProfileUpdater)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID, Name: "Pizza", Version: 1}, nil)
+
+	// Execute
+	uc := NewUpdateRestaurantProfileUseCase(mockRepo, fakeTxRunner{})
+	restaurant, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, restaurant)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	assert.Contains(t, err.Error(), "min order value cannot be negative")
+	mockRepo.AssertNotCalled(t, "UpdateProfile", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateRestaurantProfileUseCase_Execute_ReservedSlug(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	newSlug := "nearby"
+	input := UpdateRestaurantProfileInput{
+		RestaurantID: restaurantID,
+		Slug:         &newSlug,
+	}
+
+	// Mock
+	mockRepo := new(MockRestaurantProfileUpdater)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID, Name: "Pizza", Slug: "pizza-do-joao", Version: 1}, nil)
+
+	// Execute
+	uc := NewUpdateRestaurantProfileUseCase(mockRepo, fakeTxRunner{})
+	restaurant, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, restaurant)
+	var domainErr *domain.Error
+	assert.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "reserved_slug", domainErr.Code)
+	mockRepo.AssertNotCalled(t, "SlugExists", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateRestaurantProfileUseCase_Execute_ReplaceCategories(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	japanese := domain.Category{ID: uuid.New(), Slug: "japonesa", Name: "Japonesa"}
+	sushi := domain.Category{ID: uuid.New(), Slug: "sushi", Name: "Sushi"}
+	refs := []string{"Japonesa", sushi.ID.String()}
+	input := UpdateRestaurantProfileInput{
+		RestaurantID: restaurantID,
+		Categories:   &refs,
+	}
+
+	// Mock: a primeira referência vira a categoria principal
+	current := &domain.Restaurant{ID: restaurantID, Name: "Pizza", Category: "Pizza", Version: 2}
+	mockRepo := new(MockRestaurantProfileUpdater)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(current, nil)
+	mockRepo.On("ResolveCategories", ctx, []uuid.UUID{sushi.ID}, []string{"japonesa"}).Return([]domain.Category{sushi, japanese}, nil)
+	mockRepo.On("UpdateProfile", ctx, current, 2).Return(nil)
+	mockRepo.On("ReplaceCategories", ctx, restaurantID, []domain.Category{japanese, sushi}).Return(nil)
+
+	// Execute
+	uc := NewUpdateRestaurantProfileUseCase(mockRepo, fakeTxRunner{})
+	restaurant, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Japonesa", restaurant.Category)
+	assert.Equal(t, []domain.Category{japanese, sushi}, restaurant.Categories)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateRestaurantProfileUseCase_Execute_UnknownCategory(t *testing.T) {
+	// Input
+	ctx := context.Background()
+	restaurantID := uuid.New()
+	refs := []string{"pizza-vegana"}
+	input := UpdateRestaurantProfileInput{
+		RestaurantID: restaurantID,
+		Categories:   &refs,
+	}
+
+	// Mock
+	mockRepo := new(MockRestaurantProfileUpdater)
+	mockRepo.On("GetByID", ctx, restaurantID).Return(&domain.Restaurant{ID: restaurantID, Name: "Pizza", Version: 1}, nil)
+	mockRepo.On("ResolveCategories", ctx, []uuid.UUID(nil), []string{"pizza-vegana"}).Return([]domain.Category{}, nil)
+
+	// Execute
+	uc := NewUpdateRestaurantProfileUseCase(mockRepo, fakeTxRunner{})
+	restaurant, err := uc.Execute(ctx, input)
+
+	// Assert
+	assert.Nil(t, restaurant)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	mockRepo.AssertNotCalled(t, "UpdateProfile", mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "ReplaceCategories", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -3,62 +3,69 @@ package utils
 import (
 	"strings"
 	"unicode"
+
+	"golang.org/x/text/unicode/norm"
 )
 
+// MaxGeneratedSlugLength limita o tamanho de um slug gerado; o corte é feito entre palavras
+const MaxGeneratedSlugLength = 80
+
+// slugTransliterations cobre letras que a decomposição NFKD não reduz a ASCII
+// As chaves estão em minúsculas; "&" vira "e" (português) e apóstrofos unem as palavras
+var slugTransliterations = map[rune]string{
+	'ø': "o", 'ß': "ss", 'œ': "oe", 'æ': "ae", 'ł': "l", 'đ': "d", 'ð': "d",
+	'þ': "th", 'ı': "i", 'ħ': "h", 'ŧ': "t", 'ŋ': "n", 'ĸ': "k",
+	'&':  " e ",
+	'\'': "", '’': "", '‘': "", 'ʼ': "", '`': "",
+}
+
 // GenerateSlug gera um slug a partir de um nome
-// Remove acentos básicos, converte para minúsculas e substitui espaços por hífens
+// Decompõe o texto em NFKD, descarta os diacríticos, translitera o que restou pela tabela
+// e troca qualquer outro caractere (inclusive letras não latinas) por hífen
+// O resultado contém apenas [a-z0-9-], sem hífens repetidos ou nas pontas, e pode ser vazio
 func GenerateSlug(name string) string {
-	// Mapa de caracteres acentuados para não acentuados
-	accentMap := map[rune]rune{
-		'á': 'a', 'à': 'a', 'ã': 'a', 'â': 'a', 'ä': 'a',
-		'é': 'e', 'è': 'e', 'ê': 'e', 'ë': 'e',
-		'í': 'i', 'ì': 'i', 'î': 'i', 'ï': 'i',
-		'ó': 'o', 'ò': 'o', 'õ': 'o', 'ô': 'o', 'ö': 'o',
-		'ú': 'u', 'ù': 'u', 'û': 'u', 'ü': 'u',
-		'ç': 'c', 'ñ': 'n',
-		'Á': 'A', 'À': 'A', 'Ã': 'A', 'Â': 'A', 'Ä': 'A',
-		'É': 'E', 'È': 'E', 'Ê': 'E', 'Ë': 'E',
-		'Í': 'I', 'Ì': 'I', 'Î': 'I', 'Ï': 'I',
-		'Ó': 'O', 'Ò': 'O', 'Õ': 'O', 'Ô': 'O', 'Ö': 'O',
-		'Ú': 'U', 'Ù': 'U', 'Û': 'U', 'Ü': 'U',
-		'Ç': 'C', 'Ñ': 'N',
+	var builder strings.Builder
+	pendingHyphen := false
+
+	write := func(r rune) {
+		if ('a' <= r && r <= 'z') || ('0' <= r && r <= '9') {
+			if pendingHyphen && builder.Len() > 0 {
+				builder.WriteByte('-')
+			}
+			pendingHyphen = false
+			builder.WriteRune(r)
+			return
+		}
+		pendingHyphen = true
 	}
 
-	// Converter e normalizar
-	var builder strings.Builder
-	for _, r := range name {
-		// Substituir acentos
-		if replacement, ok := accentMap[r]; ok {
-			r = replacement
+	for _, r := range norm.NFKD.String(name) {
+		// Diacríticos separados pela decomposição ("é" = "e" + acento agudo)
+		if unicode.Is(unicode.Mn, r) {
+			continue
 		}
 
-		// Converter para minúsculas
 		r = unicode.ToLower(r)
-
-		// Manter apenas letras, dígitos e hífens
-		if unicode.IsLetter(r) || unicode.IsDigit(r) {
-			builder.WriteRune(r)
-		} else if r == ' ' || r == '-' || r == '_' {
-			// Adicionar hífen apenas se o último caractere não for hífen
-			if builder.Len() > 0 {
-				lastChar := builder.String()[builder.Len()-1]
-				if lastChar != '-' {
-					builder.WriteRune('-')
-				}
+		if replacement, ok := slugTransliterations[r]; ok {
+			for _, t := range replacement {
+				write(t)
 			}
+			continue
 		}
+		write(r)
 	}
 
-	slug := builder.String()
-
-	// Remover hífens do início e fim
-	slug = strings.Trim(slug, "-")
+	return truncateSlug(builder.String(), MaxGeneratedSlugLength)
+}
 
-	// Remover hífens duplicados
-	for strings.Contains(slug, "--") {
-		slug = strings.ReplaceAll(slug, "--", "-")
+// truncateSlug corta o slug no último hífen que caiba em max
+// Uma única palavra maior que max é cortada no limite
+func truncateSlug(slug string, max int) string {
+	if len(slug) <= max {
+		return slug
 	}
-
-	return slug
+	if cut := strings.LastIndexByte(slug[:max+1], '-'); cut > 0 {
+		return slug[:cut]
+	}
+	return slug[:max]
 }
-
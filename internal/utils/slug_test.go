@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// slugShape é o formato garantido por GenerateSlug (ou string vazia)
+var slugShape = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+func TestGenerateSlug(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "accents", input: "Pizza do João", want: "pizza-do-joao"},
+		{name: "cedilla and tilde", input: "Açaí & Pão de Queijo", want: "acai-e-pao-de-queijo"},
+		{name: "ampersand without spaces", input: "P&G", want: "p-e-g"},
+		{name: "nordic and german", input: "Smørrebrød Straße", want: "smorrebrod-strasse"},
+		{name: "ligatures", input: "Œufs Cocotte ﬁne", want: "oeufs-cocotte-fine"},
+		{name: "polish", input: "Łódź Grill", want: "lodz-grill"},
+		{name: "curly apostrophe", input: "Joe’s Burger", want: "joes-burger"},
+		{name: "straight apostrophe", input: "D'Ávila", want: "davila"},
+		{name: "non latin dropped", input: "すし Sushi 寿司 Bar", want: "sushi-bar"},
+		{name: "only non latin", input: "すし", want: ""},
+		{name: "fullwidth and superscript", input: "Ｃａｆé №² ", want: "cafe-no2"},
+		{name: "punctuation collapsed", input: "  --Bar__do   Zé!!--  ", want: "bar-do-ze"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Output
+			slug := GenerateSlug(tt.input)
+
+			// Assert
+			assert.Equal(t, tt.want, slug)
+		})
+	}
+}
+
+func TestGenerateSlug_TruncatesAtWordBoundary(t *testing.T) {
+	// Input
+	words := strings.Repeat("pizza ", 20)
+	single := strings.Repeat("a", 100)
+
+	// Output
+	slug := GenerateSlug(words)
+	singleSlug := GenerateSlug(single)
+
+	// Assert: 13 palavras ("pizza" + hífen) cabem em 80 caracteres
+	assert.Equal(t, strings.TrimSuffix(strings.Repeat("pizza-", 13), "-"), slug)
+	assert.Len(t, singleSlug, MaxGeneratedSlugLength)
+}
+
+func FuzzGenerateSlug(f *testing.F) {
+	seeds := []string{
+		"Pizza do João", "Açaí & Pão", "Smørrebrød", "Œuf", "Łódź", "Joe’s", "すし Bar",
+		"", "---", "ǅemal", "İstanbul", "ﬀ", "́a", strings.Repeat("café ", 30), "\xff\xfe",
+	}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		slug := GenerateSlug(input)
+
+		if slug != "" && !slugShape.MatchString(slug) {
+			t.Fatalf("GenerateSlug(%q) = %q: not [a-z0-9-] with single inner hyphens", input, slug)
+		}
+		if len(slug) > MaxGeneratedSlugLength {
+			t.Fatalf("GenerateSlug(%q) = %q: length %d exceeds %d", input, slug, len(slug), MaxGeneratedSlugLength)
+		}
+		if again := GenerateSlug(slug); again != slug {
+			t.Fatalf("GenerateSlug is not idempotent: %q -> %q -> %q", input, slug, again)
+		}
+	})
+}